@@ -16,6 +16,7 @@ package main
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -108,7 +109,31 @@ func main() {
 		mgsEnabled          = kingpin.Flag("collector.mgs", "Set MGS metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		ostEnabled          = kingpin.Flag("collector.ost", "Set OST metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		healthStatusEnabled = kingpin.Flag("collector.health", "Set Health metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
+		exportEnabled       = kingpin.Flag("collector.export", "Set per-client export metric level. Valid levels: [extended, core, disabled]").Default("disabled").Enum("extended", "core", "disabled")
+		importEnabled       = kingpin.Flag("collector.import", "Set import (client, osp and lwp connection) metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
+		hsmEnabled          = kingpin.Flag("collector.hsm", "Set HSM coordinator metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
+		quotaEnabled        = kingpin.Flag("collector.quota", "Set quota metric level. Valid levels: [extended, core, disabled]").Default("disabled").Enum("extended", "core", "disabled")
+		quotaIDs            = kingpin.Flag("collector.quota.id", "Only export the quota series of this ID (repeatable), either for every quota type ('1000') or for one of them ('usr:1000', 'grp:1000' or 'prj:1000').").Strings()
+		quotaNonZeroLimits  = kingpin.Flag("collector.quota.nonzero-limits-only", "Only export the quota series of IDs with a hard or soft limit.").Default("false").Bool()
+		quotaDerived        = kingpin.Flag("collector.quota.derived", "Compute the usage-to-limit ratio and the grace time left of each ID with a quota limit, from the quota master and the local quota slaves.").Default("false").Bool()
+		quotaDerivedMaxIDs  = kingpin.Flag("collector.quota.derived-max-ids", "Only compute derived quota series for the N IDs with the highest utilization per pool and quota type. 0 computes them for every ID.").Default("1000").Int()
+		exportTopN          = kingpin.Flag("collector.export.top-n", "Only export the N most active clients per target, folding the rest into a client named 'other'. 0 exports every client.").Default("100").Int()
+		jobStatsTopN        = kingpin.Flag("collector.job-stats.top-n", "Only export the N most active jobs per target, adding the rest up into the exporter_job_stats_suppressed gauges. 0 exports every job.").Default("0").Int()
+		jobStatsSortBy      = kingpin.Flag("collector.job-stats.sort-by", "Measure job activity in bytes read and written, or in operations performed. 'auto' uses bytes on OSTs and operations on MDTs, which have no byte counters. Valid values: [auto, bytes, ops]").Default("auto").Enum("auto", "bytes", "ops")
+		jobStatsMinActivity = kingpin.Flag("collector.job-stats.min-activity", "Drop jobs with less activity than this (in collector.job-stats.sort-by units) into the exporter_job_stats_suppressed gauges.").Default("0").Float64()
+		jobStatsTTL         = kingpin.Flag("collector.job-stats.ttl", "Drop jobs that haven't been updated for this long into the exporter_job_stats_suppressed gauges. 0 keeps every job.").Default("0s").Duration()
+		jobStatsAggregate   = kingpin.Flag("collector.job-stats.aggregate", "Roll job_stats up per target by the given key (repeatable). 'user' and 'group' need the '%u' and '%g' verbs in the jobid template, and 'project' uses the job metadata account or the '%j' portion of the job IDs. Valid values: [user, group, project]").Enums("user", "group", "project")
+		jobStatsPerJob      = kingpin.Flag("collector.job-stats.per-job", "Export per-job series from job_stats. Use --no-collector.job-stats.per-job to only export aggregations.").Default("true").Bool()
+		jobInfoFile         = kingpin.Flag("collector.job-info.file", "JSON or CSV file written by the scheduler with the user, account, partition and job name of each job ID, added as labels to job_stats series.").Default("").String()
+		jobInfoCommand      = kingpin.Flag("collector.job-info.command", "Command printing the job_id,user,account,partition,job_name of each job as CSV, run by 'sh -c', e.g. 'squeue -h -o \"%i,%u,%a,%P,%j\"'. Takes precedence over collector.job-info.file.").Default("").String()
+		jobInfoRefresh      = kingpin.Flag("collector.job-info.refresh-interval", "Interval between two reads of the job metadata file or runs of the job metadata command.").Default("1m").Duration()
+		jobInfoTTL          = kingpin.Flag("collector.job-info.ttl", "How long the metadata of a job is kept after the scheduler last reported it.").Default("10m").Duration()
+		jobIDTemplate       = kingpin.Flag("collector.jobid-template", "Template used to split job IDs into labels, in Lustre's jobid_name syntax (e.g. '%e.%u'). Defaults to the node's jobid_name setting.").Default("").String()
 		listenAddress       = kingpin.Flag("web.listen-address", "Address to use to expose Lustre metrics.").Default(":9169").String()
+		jobLedgerFile       = kingpin.Flag("collector.job-ledger.file", "JSON-lines file the final counters of each job are appended to once it disappears from job_stats. Enables the /jobs/completed endpoint.").Default("").String()
+		jobLedgerMaxSize    = kingpin.Flag("collector.job-ledger.max-size", "Size at which the job ledger file is rotated.").Default("100MB").Bytes()
+		jobLedgerMaxBackups = kingpin.Flag("collector.job-ledger.max-backups", "Number of rotated job ledger files to keep.").Default("5").Int()
+		jobLedgerRecent     = kingpin.Flag("collector.job-ledger.recent", "Number of completed jobs served by the /jobs/completed endpoint.").Default("1000").Int()
 		metricsPath         = kingpin.Flag("web.telemetry-path", "Path to use to expose Lustre metrics.").Default("/metrics").String()
 	)
 
@@ -134,6 +159,57 @@ func main() {
 	log.Infof(" - Lnet State: %s", sources.LnetEnabled)
 	sources.HealthStatusEnabled = *healthStatusEnabled
 	log.Infof(" - Health State: %s", sources.HealthStatusEnabled)
+	sources.ExportEnabled = *exportEnabled
+	sources.ExportTopN = *exportTopN
+	log.Infof(" - Export State: %s", sources.ExportEnabled)
+	sources.ImportEnabled = *importEnabled
+	log.Infof(" - Import State: %s", sources.ImportEnabled)
+	sources.HsmEnabled = *hsmEnabled
+	log.Infof(" - HSM State: %s", sources.HsmEnabled)
+	sources.QuotaEnabled = *quotaEnabled
+	sources.QuotaIDs = *quotaIDs
+	sources.QuotaNonZeroLimitsOnly = *quotaNonZeroLimits
+	sources.QuotaDerived = *quotaDerived
+	sources.QuotaDerivedMaxIDs = *quotaDerivedMaxIDs
+	log.Infof(" - Quota State: %s", sources.QuotaEnabled)
+	sources.JobStatsTopN = *jobStatsTopN
+	sources.JobStatsSortBy = *jobStatsSortBy
+	sources.JobStatsMinActivity = *jobStatsMinActivity
+	sources.JobStatsTTL = *jobStatsTTL
+	if sources.JobStatsTopN > 0 || sources.JobStatsMinActivity > 0 || sources.JobStatsTTL > 0 {
+		log.Infof(" - Job Stats Limits: top %d jobs by %s, minimum activity %g, TTL %s", sources.JobStatsTopN, sources.JobStatsSortBy, sources.JobStatsMinActivity, sources.JobStatsTTL)
+	}
+	sources.JobStatsAggregations = *jobStatsAggregate
+	sources.JobStatsPerJob = *jobStatsPerJob
+	if len(sources.JobStatsAggregations) > 0 {
+		log.Infof(" - Job Stats Aggregations: %s", strings.Join(sources.JobStatsAggregations, ", "))
+	}
+	if !sources.JobStatsPerJob {
+		log.Infof(" - Job Stats: per-job series disabled")
+	}
+	sources.JobInfoFile = *jobInfoFile
+	sources.JobInfoCommand = *jobInfoCommand
+	sources.JobInfoRefreshInterval = *jobInfoRefresh
+	sources.JobInfoTTL = *jobInfoTTL
+	if sources.JobInfoCommand != "" {
+		log.Infof(" - Job Metadata: %q every %s", sources.JobInfoCommand, sources.JobInfoRefreshInterval)
+	} else if sources.JobInfoFile != "" {
+		log.Infof(" - Job Metadata: %s every %s", sources.JobInfoFile, sources.JobInfoRefreshInterval)
+	}
+	sources.JobIDTemplate = *jobIDTemplate
+	if sources.JobIDTemplate != "" {
+		log.Infof(" - Job ID Template: %s", sources.JobIDTemplate)
+	}
+	sources.JobLedgerFile = *jobLedgerFile
+	sources.JobLedgerMaxSize = int64(*jobLedgerMaxSize)
+	sources.JobLedgerMaxBackups = *jobLedgerMaxBackups
+	sources.JobLedgerRecent = *jobLedgerRecent
+	if err := sources.OpenJobLedger(); err != nil {
+		log.Fatalf("Couldn't open job ledger: %q", err)
+	}
+	if sources.JobLedgerFile != "" {
+		log.Infof(" - Job Ledger: %s", sources.JobLedgerFile)
+	}
 
 	enabledSources := []string{"procfs", "procsys", "sysfs"}
 
@@ -151,6 +227,7 @@ func main() {
 	handler := promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{ErrorLog: log.NewErrorLogger()})
 
 	http.Handle(*metricsPath, prometheus.InstrumentHandler("prometheus", handler))
+	http.HandleFunc("/jobs/completed", sources.CompletedJobsHandler)
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		var num int
 		num, err = w.Write([]byte(`<html>
@@ -65,6 +65,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "MDT":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "extended"
@@ -74,6 +78,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "MGS":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -83,6 +91,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "MDS":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -92,6 +104,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "Client":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -101,6 +117,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "Generic":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -110,6 +130,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "extended"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "LNET":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -119,6 +143,10 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "extended"
 		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
 	case "Health":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -128,6 +156,63 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "extended"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
+	case "Export":
+		sources.OstEnabled = "disabled"
+		sources.MdtEnabled = "disabled"
+		sources.MgsEnabled = "disabled"
+		sources.MdsEnabled = "disabled"
+		sources.ClientEnabled = "disabled"
+		sources.GenericEnabled = "disabled"
+		sources.LnetEnabled = "disabled"
+		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "extended"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
+	case "Import":
+		sources.OstEnabled = "disabled"
+		sources.MdtEnabled = "disabled"
+		sources.MgsEnabled = "disabled"
+		sources.MdsEnabled = "disabled"
+		sources.ClientEnabled = "disabled"
+		sources.GenericEnabled = "disabled"
+		sources.LnetEnabled = "disabled"
+		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "extended"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "disabled"
+	case "Quota":
+		sources.OstEnabled = "disabled"
+		sources.MdtEnabled = "disabled"
+		sources.MgsEnabled = "disabled"
+		sources.MdsEnabled = "disabled"
+		sources.ClientEnabled = "disabled"
+		sources.GenericEnabled = "disabled"
+		sources.LnetEnabled = "disabled"
+		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "extended"
+		sources.QuotaDerived = true
+		sources.HsmEnabled = "disabled"
+	case "HSM":
+		sources.OstEnabled = "disabled"
+		sources.MdtEnabled = "disabled"
+		sources.MgsEnabled = "disabled"
+		sources.MdsEnabled = "disabled"
+		sources.ClientEnabled = "disabled"
+		sources.GenericEnabled = "disabled"
+		sources.LnetEnabled = "disabled"
+		sources.HealthStatusEnabled = "disabled"
+		sources.ExportEnabled = "disabled"
+		sources.ImportEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
+		sources.HsmEnabled = "extended"
 	}
 }
 
@@ -203,7 +288,7 @@ func blacklisted(blacklist []string, metricName string) bool {
 }
 
 func TestCollector(t *testing.T) {
-	targets := []string{"OST", "MDT", "MGS", "MDS", "Client", "Generic", "LNET", "Health"}
+	targets := []string{"OST", "MDT", "MGS", "MDS", "Client", "Generic", "LNET", "Health", "Export", "Import", "Quota", "HSM"}
 	// Override the default file location to the local proc directory
 	sources.ProcLocation = "proc"
 	sources.SysLocation = "sys"
@@ -1188,6 +1273,587 @@ func TestCollector(t *testing.T) {
 		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 128, false},
 		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
 		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.048576e+06, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients that completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients that completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients that completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients that completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during recovery.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_start_time_seconds", "Unix time in seconds at which the last recovery started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1510605701, false},
+		{"lustre_recovery_start_time_seconds", "Unix time in seconds at which the last recovery started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1510605726, false},
+		{"lustre_recovery_start_time_seconds", "Unix time in seconds at which the last recovery started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1510605746, false},
+		{"lustre_recovery_start_time_seconds", "Unix time in seconds at which the last recovery started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1510605761, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "complete"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "complete"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "complete"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "complete"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "inactive"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "inactive"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "inactive"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "inactive"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "recovering"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "recovering"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "recovering"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "recovering"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "waiting"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "waiting"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "waiting"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"status", "waiting"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 12, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 4208, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 219468, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 8137871, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ldlm_extent_enqueue"}, {"service", "ost"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "obd_ping"}, {"service", "ost"}, {"target", "OSS"}}, 2076, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_connect"}, {"service", "ost"}, {"target", "OSS"}}, 16, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_create"}, {"service", "ost"}, {"target", "OSS"}}, 16, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_get_info"}, {"service", "ost"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_punch"}, {"service", "ost_io"}, {"target", "OSS"}}, 57, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_statfs"}, {"service", "ost_create"}, {"target", "OSS"}}, 141654, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_write"}, {"service", "ost_io"}, {"target", "OSS"}}, 4298777, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 5585, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 345296, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 8648229, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 59, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 60, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 52, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 343159, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 21465973, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 536588137, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 18, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 296, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 1751, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 2122, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 141663, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 9512913, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 0.000512, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 0.004702, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 0.00636, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 0.092124, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 8.762843, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 135.895464, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 2113, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 141654, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 4298835, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 24, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}, {"target", "OSS"}}, 24, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}, {"target", "OSS"}}, 24, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}, {"target", "OSS"}}, 8, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}, {"target", "OSS"}}, 17, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}, {"target", "OSS"}}, 4, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"slot", "0"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"slot", "1"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"slot", "2"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"slot", "3"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"slot", "0"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"slot", "1"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"slot", "2"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"slot", "3"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"slot", "0"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"slot", "1"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"slot", "2"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"slot", "3"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"slot", "0"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"slot", "1"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"slot", "2"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"slot", "3"}, {"target", "OSS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"target", "OSS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"target", "OSS"}}, 31, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"target", "OSS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_create"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_io"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_out"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "0"}, {"service", "ost_seq"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost"}, {"target", "OSS"}}, 1510605406, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_create"}, {"target", "OSS"}}, 1510605406, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_io"}, {"target", "OSS"}}, 1510777843, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_out"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "ost"}, {"partition", "1"}, {"service", "ost_seq"}, {"target", "OSS"}}, 1510605393, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "started"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopped"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "invalid"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "started"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "starting"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopped"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}, {"state", "stopping"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_tbf_rule_classes", "Number of TBF classes, such as jobs or clients, currently throttled by the rule on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "high_priority"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_tbf_rule_classes", "Number of TBF classes, such as jobs or clients, currently throttled by the rule on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "regular"}, {"rule", "dd_user"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_tbf_rule_classes", "Number of TBF classes, such as jobs or clients, currently throttled by the rule on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "regular"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 0, false},
+		{"lustre_nrs_tbf_rule_info", "Match expression of the TBF rule, in the 'match' label.", gauge, []labelPair{{"component", "ost"}, {"match", "*"}, {"queue", "high_priority"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_tbf_rule_info", "Match expression of the TBF rule, in the 'match' label.", gauge, []labelPair{{"component", "ost"}, {"match", "*"}, {"queue", "regular"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_tbf_rule_info", "Match expression of the TBF rule, in the 'match' label.", gauge, []labelPair{{"component", "ost"}, {"match", "uid={500}&jobid={dd.*}"}, {"queue", "regular"}, {"rule", "dd_user"}, {"service", "ost_io"}, {"target", "OSS"}}, 1, false},
+		{"lustre_nrs_tbf_rule_rate", "Maximum rate in RPCs per second the TBF rule lets through on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "high_priority"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 10000, false},
+		{"lustre_nrs_tbf_rule_rate", "Maximum rate in RPCs per second the TBF rule lets through on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "regular"}, {"rule", "dd_user"}, {"service", "ost_io"}, {"target", "OSS"}}, 100, false},
+		{"lustre_nrs_tbf_rule_rate", "Maximum rate in RPCs per second the TBF rule lets through on the CPU partition.", gauge, []labelPair{{"component", "ost"}, {"cpt", "0"}, {"queue", "regular"}, {"rule", "default"}, {"service", "ost_io"}, {"target", "OSS"}}, 10000, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "inconsistent_owner"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "orphan"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "others"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}, {"type", "unmatched_pair"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "inconsistent_owner"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "orphan"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "others"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}, {"type", "unmatched_pair"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "inconsistent_owner"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "orphan"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "others"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}, {"type", "unmatched_pair"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "inconsistent_owner"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "orphan"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "others"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}, {"type", "unmatched_pair"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-failed"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-failed"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-failed"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-failed"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-paused"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-paused"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-paused"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-paused"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "completed"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "completed"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "completed"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "completed"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "crashed"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "crashed"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "crashed"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "crashed"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "failed"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "failed"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "failed"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "failed"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "init"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "init"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "init"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "init"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "partial"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "partial"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "partial"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "partial"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "paused"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "paused"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "paused"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "paused"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "stopped"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "stopped"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "stopped"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"status", "stopped"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "ost"}, {"kind", "layout"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_object_cache_buckets", "Number of hash buckets of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
+		{"lustre_object_cache_buckets", "Number of hash buckets of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4096, false},
+		{"lustre_object_cache_buckets", "Number of hash buckets of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 4096, false},
+		{"lustre_object_cache_buckets", "Number of hash buckets of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 4096, false},
+		{"lustre_object_cache_busy_objects", "Number of objects of the LU object cache currently in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 20, false},
+		{"lustre_object_cache_busy_objects", "Number of objects of the LU object cache currently in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 20, false},
+		{"lustre_object_cache_busy_objects", "Number of objects of the LU object cache currently in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 20, false},
+		{"lustre_object_cache_busy_objects", "Number of objects of the LU object cache currently in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 20, false},
+		{"lustre_object_cache_created_total", "Total number of objects created in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 104, false},
+		{"lustre_object_cache_created_total", "Total number of objects created in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 104, false},
+		{"lustre_object_cache_created_total", "Total number of objects created in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 104, false},
+		{"lustre_object_cache_created_total", "Total number of objects created in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 104, false},
+		{"lustre_object_cache_death_races_total", "Total number of lookups which found an object of the LU object cache being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_object_cache_death_races_total", "Total number of lookups which found an object of the LU object cache being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_object_cache_death_races_total", "Total number of lookups which found an object of the LU object cache being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_object_cache_death_races_total", "Total number of lookups which found an object of the LU object cache being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_object_cache_hits_total", "Total number of lookups found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 8597582, false},
+		{"lustre_object_cache_hits_total", "Total number of lookups found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 45, false},
+		{"lustre_object_cache_hits_total", "Total number of lookups found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 45, false},
+		{"lustre_object_cache_hits_total", "Total number of lookups found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 45, false},
+		{"lustre_object_cache_lru_purged_total", "Total number of objects purged from the LRU list of the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_object_cache_lru_purged_total", "Total number of objects purged from the LRU list of the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_object_cache_lru_purged_total", "Total number of objects purged from the LRU list of the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_object_cache_lru_purged_total", "Total number of objects purged from the LRU list of the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_object_cache_maximum_search_depth", "Maximum number of objects searched through in a hash bucket of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_object_cache_maximum_search_depth", "Maximum number of objects searched through in a hash bucket of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_object_cache_maximum_search_depth", "Maximum number of objects searched through in a hash bucket of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_object_cache_maximum_search_depth", "Maximum number of objects searched through in a hash bucket of the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_object_cache_misses_total", "Total number of lookups not found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 103, false},
+		{"lustre_object_cache_misses_total", "Total number of lookups not found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 103, false},
+		{"lustre_object_cache_misses_total", "Total number of lookups not found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 103, false},
+		{"lustre_object_cache_misses_total", "Total number of lookups not found in the LU object cache.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 103, false},
+		{"lustre_object_cache_objects", "Number of objects in the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 56, false},
+		{"lustre_object_cache_objects", "Number of objects in the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 56, false},
+		{"lustre_object_cache_objects", "Number of objects in the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 56, false},
+		{"lustre_object_cache_objects", "Number of objects in the LU object cache.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 56, false},
+		{"lustre_object_cache_populated_buckets", "Number of hash buckets of the LU object cache holding at least one object.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 56, false},
+		{"lustre_object_cache_populated_buckets", "Number of hash buckets of the LU object cache holding at least one object.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 56, false},
+		{"lustre_object_cache_populated_buckets", "Number of hash buckets of the LU object cache holding at least one object.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 56, false},
+		{"lustre_object_cache_populated_buckets", "Number of hash buckets of the LU object cache holding at least one object.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 56, false},
+		{"lustre_object_cache_races_total", "Total number of objects concurrently added to the LU object cache by two threads.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_object_cache_races_total", "Total number of objects concurrently added to the LU object cache by two threads.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_object_cache_races_total", "Total number of objects concurrently added to the LU object cache by two threads.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_object_cache_races_total", "Total number of objects concurrently added to the LU object cache by two threads.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
 
 		// MDT Metrics
 		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 0, false},
@@ -1443,6 +2109,202 @@ func TestCollector(t *testing.T) {
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241498368e+09, false},
 		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4.30405292e+08, false},
 		{"lustre_free_kilobytes", "Number of kilobytes allocated to the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241500416e+09, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"status", "complete"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"status", "inactive"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"status", "recovering"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_recovery_status", "Current recovery status of the target: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"status", "waiting"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the last or current LFSCK scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects LFSCK failed to check or repair during the last or current scan, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "inconsistent_owner"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "orphan"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "others"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}, {"type", "unmatched_pair"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "bad_file_type"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "dangling"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "dirent"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "linkea"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "lost_dirent"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "multiple_linked"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "multiple_referenced"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "name_hash"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "nlinks"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "striped_dirs"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "striped_shards"}}, 0, false},
+		{"lustre_lfsck_repaired_total", "Total number of inconsistencies repaired by LFSCK, by type of inconsistency.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}, {"type", "unmatched_pairs"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds the last or current LFSCK scan has run, by phase.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"phase", "phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "co-failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "co-paused"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "co-stopped"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "completed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "crashed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "init"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "partial"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "paused"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "scanning-phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "scanning-phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"status", "stopped"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "co-failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "co-paused"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "co-stopped"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "completed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "crashed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "init"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "partial"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "paused"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "scanning-phase1"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "scanning-phase2"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_status", "Current status of the LFSCK scan: 1 for the reported status, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"status", "stopped"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "mdt"}, {"kind", "layout"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of LFSCK scans that completed successfully.", counter, []labelPair{{"component", "mdt"}, {"kind", "namespace"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_changelog_current_index", "Index of the last record written to the changelog of the MDT.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_buckets_minimum", "Minimum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets_minimum", "Minimum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets_minimum", "Minimum number of buckets of the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_items", "Number of items in the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_hash_items", "Number of items in the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 4, false},
+		{"lustre_hash_items", "Number of items in the hash table.", gauge, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_hash_load_factor", "Current load factor of the hash table, in items per bucket.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 0.07, false},
+		{"lustre_hash_load_factor", "Current load factor of the hash table, in items per bucket.", gauge, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 0.031, false},
+		{"lustre_hash_load_factor", "Current load factor of the hash table, in items per bucket.", gauge, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 0.07, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table was resized.", counter, []labelPair{{"component", "mdt"}, {"hash", "nid_hash"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table was resized.", counter, []labelPair{{"component", "mdt"}, {"hash", "nid_stats"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table was resized.", counter, []labelPair{{"component", "mdt"}, {"hash", "uuid_hash"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_object_cache_buckets", "Number of hash buckets of the LU object cache.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_object_cache_busy_objects", "Number of objects of the LU object cache currently in use.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 91, false},
+		{"lustre_object_cache_created_total", "Total number of objects created in the LU object cache.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_object_cache_death_races_total", "Total number of lookups which found an object of the LU object cache being freed.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_object_cache_hits_total", "Total number of lookups found in the LU object cache.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 167, false},
+		{"lustre_object_cache_lru_purged_total", "Total number of objects purged from the LRU list of the LU object cache.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_object_cache_maximum_search_depth", "Maximum number of objects searched through in a hash bucket of the LU object cache.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_object_cache_misses_total", "Total number of lookups not found in the LU object cache.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 125, false},
+		{"lustre_object_cache_objects", "Number of objects in the LU object cache.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 99, false},
+		{"lustre_object_cache_populated_buckets", "Number of hash buckets of the LU object cache holding at least one object.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 88, false},
+		{"lustre_object_cache_races_total", "Total number of objects concurrently added to the LU object cache by two threads.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lov_default_stripe_count", "Default number of OSTs a file is striped over.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_default_stripe_offset", "Default index of the first OST a file is striped over, -1 to let the layout device choose.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, -1, false},
+		{"lustre_lov_default_stripe_size_bytes", "Default size in bytes of a stripe.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1048576, false},
+		{"lustre_lov_qos_maximum_age_seconds", "Maximum age in seconds of the OST usage the QoS allocator works with.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 5, false},
+		{"lustre_lov_qos_prio_free_ratio", "Weight given to the free space of the OSTs by the QoS allocator, as a ratio.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 0.91, false},
+		{"lustre_lov_qos_threshold_rr_ratio", "Free space imbalance between OSTs above which the QoS allocator replaces round-robin, as a ratio.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 0.17, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0000"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0001"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0002"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0003"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0004"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0005"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0006"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lov_targets", "Number of OSTs of the layout device, by state.", gauge, []labelPair{{"component", "mdt"}, {"state", "active"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 7, false},
+		{"lustre_lov_targets", "Number of OSTs of the layout device, by state.", gauge, []labelPair{{"component", "mdt"}, {"state", "inactive"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 0, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroys in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects the MDT asks the OST to precreate at once.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_old_sync_processed", "Returns '1' if the changes left from before the last MDT restart were sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 67, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID the MDT will hand out for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for files being created.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label.", gauge, []labelPair{{"component", "mdt"}, {"errno", "none"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes of the OST above which the MDT allocates objects on it again.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes of the OST below which the MDT stops allocating objects on it.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of object destroy and setattr changes waiting to be sent to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of object destroy and setattr RPCs in flight to the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of object destroy and setattr changes being processed for the OST.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
 
 		// MGS Metrics
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"target", "osd"}, {"component", "mgs"}}, 1.12074688e+09, false},
@@ -1452,6 +2314,434 @@ func TestCollector(t *testing.T) {
 		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 2.31004127e+08, false},
 		{"lustre_free_kilobytes", "Number of kilobytes allocated to the pool", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 1.120748928e+09, false},
 
+		// MDS Metrics
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 7, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_active_requests_maximum", "Highest number of requests the service was handling at once.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 119847, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 13, false},
+		{"lustre_service_active_requests_total", "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "fld_read"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "ldlm_ibits_enqueue"}, {"service", "mdt"}, {"target", "MDS"}}, 28, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_close"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 9, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_connect"}, {"service", "mdt"}, {"target", "MDS"}}, 15, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_disconnect"}, {"service", "mdt"}, {"target", "MDS"}}, 5, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_get_root"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_getattr"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_hsm_state_set"}, {"service", "mdt"}, {"target", "MDS"}}, 13, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_readpage"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_reint_open"}, {"service", "mdt"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_reint_setattr"}, {"service", "mdt"}, {"target", "MDS"}}, 57, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_statfs"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "obd_ping"}, {"service", "mdt"}, {"target", "MDS"}}, 57146, false},
+		{"lustre_service_operations_total", "Total number of requests the service has handled, by operation.", counter, []labelPair{{"component", "mds"}, {"operation", "seq_query"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 138779, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 26, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 39, false},
+		{"lustre_service_request_buffer_samples_total", "Total number of times the available request buffers of the service were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 3, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 63, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 64, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 63, false},
+		{"lustre_service_request_buffers_available_minimum", "Lowest number of request buffers that were available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 64, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 8880960, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1664, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 2490, false},
+		{"lustre_service_request_buffers_available_total", "Sum of the request buffers available each time they were sampled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 192, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of requests currently kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of requests kept in the request history of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Deepest request queue seen by a request of the service on arrival.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 234, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_queue_depth_total", "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 57294, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 19, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 22, false},
+		{"lustre_service_request_timeout_seconds_total", "Sum of the timeouts in seconds given to each request handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 0.006033, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 8.4e-05, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 8.4e-05, false},
+		{"lustre_service_request_wait_maximum_seconds", "Longest time in seconds a request waited in the queue of the service before being handled.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 7.3e-05, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 4.709981, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0.000744, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0.000762, false},
+		{"lustre_service_request_wait_seconds_total", "Total time in seconds requests waited in the queue of the service before being handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 7.3e-05, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 57267, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 13, false},
+		{"lustre_service_requests_total", "Total number of requests the service has handled.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}, {"target", "MDS"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 120, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads the service can start.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 120, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads the service keeps started.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}, {"target", "MDS"}}, 17, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads currently started by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 4, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"slot", "0"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"slot", "1"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"slot", "2"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"slot", "3"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"slot", "0"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"slot", "1"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"slot", "3"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"slot", "0"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"slot", "0"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"slot", "1"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"slot", "2"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"slot", "3"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"slot", "0"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"slot", "0"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"slot", "1"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"slot", "2"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"slot", "3"}, {"target", "MDS"}}, 0, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1, false},
+		{"lustre_service_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 10, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt"}, {"target", "MDS"}}, 1510604533, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1510605407, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_out"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1510766441, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "0"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt"}, {"target", "MDS"}}, 1510697668, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_out"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1510766398, false},
+		{"lustre_service_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mds"}, {"partition", "1"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 1510604508, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests currently being handled from the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_fallback", "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests currently queued by the NRS policy.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "started"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopped"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "invalid"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "started"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "starting"}, {"target", "MDS"}}, 0, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopped"}, {"target", "MDS"}}, 1, false},
+		{"lustre_nrs_policy_state", "Current state of the NRS policy: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}, {"state", "stopping"}, {"target", "MDS"}}, 0, false},
+
 		// Client Metrics
 		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
 		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
@@ -1642,6 +2932,18 @@ func TestCollector(t *testing.T) {
 		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 832325, false},
 		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 497409, false},
 		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 272560, false},
+		{"lustre_lov_default_stripe_count", "Default number of OSTs a file is striped over.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_default_stripe_offset", "Default index of the first OST a file is striped over, -1 to let the layout device choose.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, -1, false},
+		{"lustre_lov_default_stripe_size_bytes", "Default size in bytes of a stripe.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1048576, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0000"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0001"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0002"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0003"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0004"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0005"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Whether the OST is active for the layout device: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0006"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_targets", "Number of OSTs of the layout device, by state.", gauge, []labelPair{{"component", "client"}, {"state", "active"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 7, false},
+		{"lustre_lov_targets", "Number of OSTs of the layout device, by state.", gauge, []labelPair{{"component", "client"}, {"state", "inactive"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 0, false},
 
 		// Generic Metrics
 		{"lustre_cache_miss_total", "Total number of cache misses.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
@@ -1659,6 +2961,11 @@ func TestCollector(t *testing.T) {
 		{"lustre_shrinks_total", "Total number of shrinks.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_free_page_low", "Lowest number of free pages reached.", gauge, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_out_of_memory_request_total", "Total number of out of memory requests.", 0, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
+		{"lustre_adaptive_timeout_early_margin_seconds", "Time in seconds before the deadline of a request at which servers send an early reply.", gauge, []labelPair{{"component", "generic"}, {"target", "lustre"}}, 5, false},
+		{"lustre_adaptive_timeout_extra_seconds", "Time in seconds by which servers extend the deadline of a request they can't serve in time.", gauge, []labelPair{{"component", "generic"}, {"target", "lustre"}}, 30, false},
+		{"lustre_adaptive_timeout_history_window_seconds", "Time in seconds over which the adaptive timeout history is kept.", gauge, []labelPair{{"component", "generic"}, {"target", "lustre"}}, 600, false},
+		{"lustre_adaptive_timeout_maximum_seconds", "Maximum adaptive timeout in seconds.", gauge, []labelPair{{"component", "generic"}, {"target", "lustre"}}, 600, false},
+		{"lustre_adaptive_timeout_minimum_seconds", "Minimum adaptive timeout in seconds.", gauge, []labelPair{{"component", "generic"}, {"target", "lustre"}}, 0, false},
 
 		// LNET Metrics
 		{"lustre_console_max_delay_centiseconds", "Minimum time in centiseconds before the console logs a message", gauge, []labelPair{{"component", "lnet"}, {"target", "lnet"}}, 60000, false},
@@ -1686,6 +2993,819 @@ func TestCollector(t *testing.T) {
 
 		//Health metrics
 		{"lustre_health_check", "Current health status for the indicated instance: 1 refers to 'healthy', 0 refers to 'unhealthy'", gauge, []labelPair{{"component", "health"}, {"target", "lustre"}}, 1, false},
+
+		//Export metrics
+		{"lustre_export_ldlm_requests_total", "Number of lock requests the client has made.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_bl_callback"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_export_ldlm_requests_total", "Number of lock requests the client has made.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_cancel"}, {"target", "lustrefs-MDT0000"}}, 14, false},
+		{"lustre_export_ldlm_requests_total", "Number of lock requests the client has made.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_enqueue"}, {"target", "lustrefs-MDT0000"}}, 28, false},
+		{"lustre_export_ldlm_latency_seconds_total", "Total time in seconds spent serving the lock requests of the client.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_cancel"}, {"target", "lustrefs-MDT0000"}}, 5e-05, false},
+		{"lustre_export_ldlm_latency_seconds_total", "Total time in seconds spent serving the lock requests of the client.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_enqueue"}, {"target", "lustrefs-MDT0000"}}, 0.003, false},
+		{"lustre_export_ldlm_requests_total", "Number of lock requests the client has made.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_cancel"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_export_ldlm_requests_total", "Number of lock requests the client has made.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"operation", "ldlm_enqueue"}, {"target", "lustrefs-MDT0000"}}, 6, false},
+		{"lustre_export_open_files", "Number of files the client currently has open.", gauge, []labelPair{{"client_nid", "0@lo"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_open_files", "Number of files the client currently has open.", gauge, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_export_open_files", "Number of files the client currently has open.", gauge, []labelPair{{"client_nid", "172.20.20.5@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_open_files", "Number of files the client currently has open.", gauge, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots", "Number of reply data slots currently held for the client.", gauge, []labelPair{{"client_nid", "0@lo"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots", "Number of reply data slots currently held for the client.", gauge, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_export_reply_data_slots", "Number of reply data slots currently held for the client.", gauge, []labelPair{{"client_nid", "172.20.20.5@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots", "Number of reply data slots currently held for the client.", gauge, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots_maximum", "Highest number of reply data slots held for the client at once.", gauge, []labelPair{{"client_nid", "0@lo"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots_maximum", "Highest number of reply data slots held for the client at once.", gauge, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 8, false},
+		{"lustre_export_reply_data_slots_maximum", "Highest number of reply data slots held for the client at once.", gauge, []labelPair{{"client_nid", "172.20.20.5@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_data_slots_maximum", "Highest number of reply data slots held for the client at once.", gauge, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_tag_total", "Total number of reply data slots released after the client reused the tag.", counter, []labelPair{{"client_nid", "0@lo"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_tag_total", "Total number of reply data slots released after the client reused the tag.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 11, false},
+		{"lustre_export_reply_released_by_tag_total", "Total number of reply data slots released after the client reused the tag.", counter, []labelPair{{"client_nid", "172.20.20.5@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_tag_total", "Total number of reply data slots released after the client reused the tag.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_xid_total", "Total number of reply data slots released after the client acknowledged the reply by XID.", counter, []labelPair{{"client_nid", "0@lo"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_xid_total", "Total number of reply data slots released after the client acknowledged the reply by XID.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 65, false},
+		{"lustre_export_reply_released_by_xid_total", "Total number of reply data slots released after the client acknowledged the reply by XID.", counter, []labelPair{{"client_nid", "172.20.20.5@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_reply_released_by_xid_total", "Total number of reply data slots released after the client acknowledged the reply by XID.", counter, []labelPair{{"client_nid", "172.20.20.6@o2ib"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 2, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0002"}}, 2, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0004"}}, 2, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0006"}}, 2, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 35359, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0002"}}, 35354, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0004"}}, 35350, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.2@o2ib"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0006"}}, 35347, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 16, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 10, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 57, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "mdt"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "commitrw"}, {"target", "lustrefs-OST0000"}}, 4298710, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}}, 140, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}}, 644, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}}, 644, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}}, 644, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "preprw"}, {"target", "lustrefs-OST0000"}}, 4298711, false},
+		{"lustre_export_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 57, false},
+		{"lustre_export_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 16552048697344, false},
+		{"lustre_export_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4194304, false},
+		{"lustre_export_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
+		{"lustre_export_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"client_nid", "172.20.20.4@o2ib"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4298711, false},
+
+		//Import metrics
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0.000408, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0.006054, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0.000354, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0.000338, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0.00034, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0.000331, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0.000334, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0.000334, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0.0003, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0.000316, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0.000301, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0.000325, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0.000308, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0.000301, false},
+		{"lustre_import_average_wait_time_seconds", "Average time in seconds RPCs waited for a reply on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0.000304, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 23, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 26, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 23, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of attempts to connect the import to its target.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "mdc"}, {"nid", "172.20.20.2@o2ib"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "mgc"}, {"nid", "172.20.20.1@o2ib"}, {"target", "MGC172.20.20.1@o2ib"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osc"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Server NID the import is currently connected to, in the 'nid' label.", gauge, []labelPair{{"component", "osp"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_network_estimate_seconds", "Adaptive timeout estimate in seconds of the network latency to the target.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_inflight", "Number of RPCs currently in flight on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 22, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpcs_timeouts", "Number of RPCs that timed out on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 22, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_unregistering", "Number of RPCs currently being unregistered on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "closed"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "connecting"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "disconn"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "evicted"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "full"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "idle"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "new"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "recover"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "replay"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "replay_locks"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdc"}, {"state", "replay_wait"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "closed"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "connecting"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "disconn"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "evicted"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "full"}, {"target", "MGC172.20.20.1@o2ib"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "idle"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "new"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "recover"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "replay"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "replay_locks"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mgc"}, {"state", "replay_wait"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "closed"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "connecting"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "disconn"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "evicted"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "full"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "idle"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "new"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "recover"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osc"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "closed"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "connecting"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "disconn"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "evicted"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "full"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "idle"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "new"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "recover"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_locks"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Current state of the import: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "osp"}, {"state", "replay_wait"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_client_evictions_total", "Total number of evictions observed in the import state history since the exporter started.", counter, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"slot", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"slot", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"slot", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"slot", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"slot", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"slot", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"slot", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"slot", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"slot", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"slot", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"slot", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"slot", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"slot", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"slot", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"slot", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"slot", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "0"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "1"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "2"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_history_seconds", "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"slot", "3"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_seconds", "Current adaptive timeout estimate in seconds.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 31, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_seconds", "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "network"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_12"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_17"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510766450, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_23"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510766397, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "mdc"}, {"estimate", "portal_30"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510766440, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_17"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510806439, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1510766261, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510777840, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osc"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1510605709, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1510605920, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1510605734, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1510605463, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1510605754, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1510605450, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1510605769, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1510605413, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1510605436, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1510605413, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1510605463, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1510605468, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1510605450, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_28"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1510605468, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1510605413, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1510605436, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1510605413, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1510605463, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1510605468, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1510605450, false},
+		{"lustre_import_adaptive_timeout_worst_time_seconds", "Unix time in seconds at which the worst adaptive timeout estimate was seen.", gauge, []labelPair{{"component", "osp"}, {"estimate", "portal_7"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1510605468, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "mdc"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1510950432, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osc"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 1510950459, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1510781851, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1510781851, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1510781851, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1510781850, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1510781851, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1510781852, false},
+		{"lustre_import_last_reply_time_seconds", "Unix time in seconds at which the last reply was received on the import.", gauge, []labelPair{{"component", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1510781851, false},
+
+		//Quota metrics
+		{"lustre_quota_granted_bytes", "Block quota granted to the quota slaves for the ID in bytes.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_granted_bytes", "Block quota granted to the quota slaves for the ID in bytes.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_granted_bytes", "Block quota granted to the quota slaves for the ID in bytes.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Inode quota granted to the quota slaves for the ID.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Inode quota granted to the quota slaves for the ID.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Inode quota granted to the quota slaves for the ID.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_limit_hard_bytes", "Hard block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_limit_hard_bytes", "Hard block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_limit_hard_bytes", "Hard block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_limit_hard_inodes", "Hard inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_limit_hard_inodes", "Hard inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_limit_hard_inodes", "Hard inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_limit_soft_bytes", "Soft block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_limit_soft_bytes", "Soft block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_limit_soft_bytes", "Soft block quota limit of the ID in bytes. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "dt-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_limit_soft_inodes", "Soft inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "grp"}}, 0, false},
+		{"lustre_quota_limit_soft_inodes", "Soft inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "prj"}}, 0, false},
+		{"lustre_quota_limit_soft_inodes", "Soft inode quota limit of the ID. 0 means no limit.", gauge, []labelPair{{"component", "qmt"}, {"id", "0"}, {"pool", "md-0x0"}, {"target", "lustrefs-QMT0000"}, {"type", "usr"}}, 0, false},
+		{"lustre_quota_slave_info", "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels.", gauge, []labelPair{{"component", "mdt"}, {"conn_to_master", "setup"}, {"enabled", "none"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_quota_slave_info", "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels.", gauge, []labelPair{{"component", "ost"}, {"conn_to_master", "setup"}, {"enabled", "none"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_quota_slave_info", "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels.", gauge, []labelPair{{"component", "ost"}, {"conn_to_master", "setup"}, {"enabled", "none"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_quota_slave_info", "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels.", gauge, []labelPair{{"component", "ost"}, {"conn_to_master", "setup"}, {"enabled", "none"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_quota_slave_info", "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels.", gauge, []labelPair{{"component", "ost"}, {"conn_to_master", "setup"}, {"enabled", "none"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "mdt"}, {"id", "0"}, {"target", "lustrefs-MDT0000"}, {"type", "grp"}}, 6259712, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "mdt"}, {"id", "0"}, {"target", "lustrefs-MDT0000"}, {"type", "usr"}}, 6259712, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0000"}, {"type", "grp"}}, 142260532224, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0000"}, {"type", "usr"}}, 142260532224, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0002"}, {"type", "grp"}}, 10565632, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0002"}, {"type", "usr"}}, 10565632, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0004"}, {"type", "grp"}}, 10565632, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0004"}, {"type", "usr"}}, 10565632, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0006"}, {"type", "grp"}}, 10565632, false},
+		{"lustre_quota_usage_bytes", "Space used by the ID on the target in bytes.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0006"}, {"type", "usr"}}, 10565632, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "mdt"}, {"id", "0"}, {"target", "lustrefs-MDT0000"}, {"type", "grp"}}, 225, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "mdt"}, {"id", "0"}, {"target", "lustrefs-MDT0000"}, {"type", "usr"}}, 225, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0000"}, {"type", "grp"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0000"}, {"type", "usr"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0002"}, {"type", "grp"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0002"}, {"type", "usr"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0004"}, {"type", "grp"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0004"}, {"type", "usr"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0006"}, {"type", "grp"}}, 251, false},
+		{"lustre_quota_usage_inodes", "Number of inodes used by the ID on the target.", gauge, []labelPair{{"component", "ost"}, {"id", "0"}, {"target", "lustrefs-OST0006"}, {"type", "usr"}}, 251, false},
+
+		//HSM metrics
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "archive"}, {"component", "mdt"}, {"status", "canceled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "archive"}, {"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "archive"}, {"component", "mdt"}, {"status", "started"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "archive"}, {"component", "mdt"}, {"status", "succeed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "archive"}, {"component", "mdt"}, {"status", "waiting"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "cancel"}, {"component", "mdt"}, {"status", "canceled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "cancel"}, {"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "cancel"}, {"component", "mdt"}, {"status", "started"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "cancel"}, {"component", "mdt"}, {"status", "succeed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "cancel"}, {"component", "mdt"}, {"status", "waiting"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "remove"}, {"component", "mdt"}, {"status", "canceled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "remove"}, {"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "remove"}, {"component", "mdt"}, {"status", "started"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "remove"}, {"component", "mdt"}, {"status", "succeed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "remove"}, {"component", "mdt"}, {"status", "waiting"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "restore"}, {"component", "mdt"}, {"status", "canceled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "restore"}, {"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "restore"}, {"component", "mdt"}, {"status", "started"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "restore"}, {"component", "mdt"}, {"status", "succeed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the coordinator log, by action and status.", gauge, []labelPair{{"action", "restore"}, {"component", "mdt"}, {"status", "waiting"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_active_request_timeout_seconds", "Time in seconds after which an HSM request without progress from its copytool agent is canceled", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3600, false},
+		{"lustre_hsm_active_requests", "Number of HSM requests currently being handled by copytool agents.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Current state of the HSM coordinator: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"state", "disabled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Current state of the HSM coordinator: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"state", "enabled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Current state of the HSM coordinator: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"state", "init"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Current state of the HSM coordinator: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"state", "stopped"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_coordinator_state", "Current state of the HSM coordinator: 1 for the reported state, 0 for the others.", gauge, []labelPair{{"component", "mdt"}, {"state", "stopping"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_default_archive_id", "Archive ID used by HSM requests which don't set one", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_grace_delay_seconds", "Time in seconds finished HSM requests are kept in the coordinator log", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 60, false},
+		{"lustre_hsm_loop_period_seconds", "Time in seconds between two runs of the HSM coordinator", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 10, false},
+		{"lustre_hsm_max_requests", "Maximum number of HSM requests copytool agents can handle at the same time", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3, false},
+		{"lustre_hsm_policy", "Whether the HSM coordinator policy is set: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"policy", "NoRetryAction"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_policy", "Whether the HSM coordinator policy is set: 1 if it is, 0 otherwise.", gauge, []labelPair{{"component", "mdt"}, {"policy", "NonBlockingRestore"}, {"target", "lustrefs-MDT0000"}}, 0, false},
 	}
 
 	// These following metrics should be filtered out as they are specific to the deployment and will always change
@@ -0,0 +1,120 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	changelogUsers string = "changelog_users"
+
+	// Help text dedicated to the 'changelog_users' file
+	changelogCurrentIndexHelp string = "Index of the last record written to the changelog of the MDT."
+	changelogUserIndexHelp    string = "Index of the last changelog record cleared by the changelog user."
+	changelogUserLagHelp      string = "Number of changelog records written since the last one cleared by the changelog user."
+	changelogUserIdleHelp     string = "Time in seconds since the changelog user last cleared records."
+)
+
+var (
+	// changelogCurrentIndexPattern matches the 'current index: {n}' line, spelled 'current_index' by newer releases
+	changelogCurrentIndexPattern = regexp.MustCompile(`^current[ _]index:\s*([0-9]+)`)
+	// changelogUserPattern matches the '{user} {index}' lines of the users, followed by '({idle seconds})' on releases
+	// which track it
+	changelogUserPattern = regexp.MustCompile(`^(cl[0-9]+\S*)\s+([0-9]+)(?:\s+\(([0-9]+)\))?`)
+)
+
+// changelogUser is a registered consumer of the changelog of an MDT.
+type changelogUser struct {
+	name    string
+	index   float64
+	idle    float64
+	hasIdle bool
+}
+
+// changelogUsersRecord is the content of a 'changelog_users' file.
+type changelogUsersRecord struct {
+	currentIndex float64
+	users        []changelogUser
+}
+
+// parseChangelogUsers reads a 'changelog_users' file, such as:
+//
+//	current index: 1234
+//	ID    index (idle seconds)
+//	cl1   1200 (35)
+func parseChangelogUsers(content string) (record changelogUsersRecord, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if match := changelogCurrentIndexPattern.FindStringSubmatch(line); match != nil {
+			if record.currentIndex, err = strconv.ParseFloat(match[1], 64); err != nil {
+				return record, err
+			}
+			continue
+		}
+		match := changelogUserPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		user := changelogUser{name: match[1]}
+		if user.index, err = strconv.ParseFloat(match[2], 64); err != nil {
+			return record, err
+		}
+		if match[3] != "" {
+			if user.idle, err = strconv.ParseFloat(match[3], 64); err != nil {
+				return record, err
+			}
+			user.hasIdle = true
+		}
+		record.users = append(record.users, user)
+	}
+	return record, nil
+}
+
+func readChangelogUsers(path string) (changelogUsersRecord, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return changelogUsersRecord{}, err
+	}
+	return parseChangelogUsers(string(content))
+}
+
+func getChangelogUsersMetrics(record changelogUsersRecord, promName string, helpText string) (metricList []lustreStatsMetric) {
+	if helpText == changelogCurrentIndexHelp {
+		return []lustreStatsMetric{{title: promName, help: helpText, value: record.currentIndex}}
+	}
+	for _, user := range record.users {
+		item := lustreStatsMetric{title: promName, help: helpText, extraLabel: "user", extraLabelValue: user.name}
+		switch helpText {
+		case changelogUserIndexHelp:
+			item.value = user.index
+		case changelogUserLagHelp:
+			// Users registered after the last record was written can be ahead of the current index
+			if user.index < record.currentIndex {
+				item.value = record.currentIndex - user.index
+			}
+		case changelogUserIdleHelp:
+			if !user.hasIdle {
+				continue
+			}
+			item.value = user.idle
+		}
+		metricList = append(metricList, item)
+	}
+	return metricList
+}
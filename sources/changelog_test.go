@@ -0,0 +1,61 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseChangelogUsers(t *testing.T) {
+	// Releases which don't track the idle time of users
+	record, err := parseChangelogUsers("current index: 1234\nID    index\ncl1   1200\ncl2   1234\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := changelogUsersRecord{
+		currentIndex: 1234,
+		users: []changelogUser{
+			{name: "cl1", index: 1200},
+			{name: "cl2", index: 1234},
+		},
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Fatalf("Unexpected record: %+v, expected %+v", record, expected)
+	}
+	if idle := getChangelogUsersMetrics(record, "test", changelogUserIdleHelp); len(idle) != 0 {
+		t.Fatalf("Expected no idle time, got %+v", idle)
+	}
+
+	// Releases which track it
+	record, err = parseChangelogUsers("current_index: 5000\nID                            index (idle)\ncl1-robinhood                 4000 (35)\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = changelogUsersRecord{
+		currentIndex: 5000,
+		users:        []changelogUser{{name: "cl1-robinhood", index: 4000, idle: 35, hasIdle: true}},
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Fatalf("Unexpected record: %+v, expected %+v", record, expected)
+	}
+	lag := getChangelogUsersMetrics(record, "test", changelogUserLagHelp)
+	if len(lag) != 1 || lag[0].extraLabel != "user" || lag[0].extraLabelValue != "cl1-robinhood" || lag[0].value != 1000 {
+		t.Fatalf("Unexpected lag metrics: %+v", lag)
+	}
+	idle := getChangelogUsersMetrics(record, "test", changelogUserIdleHelp)
+	if len(idle) != 1 || idle[0].value != 35 {
+		t.Fatalf("Unexpected idle metrics: %+v", idle)
+	}
+}
@@ -0,0 +1,270 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Per-client files found in the exports directory of OSTs and MDTs
+	exportsDir      string = "exports/"
+	exportStats     string = exportsDir + "*/stats"
+	exportLdlmStats string = exportsDir + "*/ldlm_stats"
+	exportOpenFiles string = exportsDir + "*/open_files"
+	exportReplyData string = exportsDir + "*/reply_data"
+
+	// Help text dedicated to the export cardinality guard
+	exportSuppressedHelp string = "Number of clients folded into the 'other' client during the last scrape."
+
+	// Help text dedicated to the 'ldlm_stats', 'open_files' and 'reply_data' export files
+	ldlmRequestsHelp       string = "Number of lock requests the client has made."
+	ldlmLatencyHelp        string = "Total time in seconds spent serving the lock requests of the client."
+	openFilesHelp          string = "Number of files the client currently has open."
+	replyCountHelp         string = "Number of reply data slots currently held for the client."
+	replyMaximumHelp       string = "Highest number of reply data slots held for the client at once."
+	replyReleasedByXIDHelp string = "Total number of reply data slots released after the client acknowledged the reply by XID."
+	replyReleasedByTagHelp string = "Total number of reply data slots released after the client reused the tag."
+)
+
+var (
+	// ExportEnabled specifies whether to collect per-client export metrics
+	ExportEnabled string
+	// ExportTopN is the number of most active clients exported per target, the rest being folded into a client
+	// named 'other'. 0 exports every client.
+	ExportTopN int
+)
+
+// parseStatsRecord reads a stats file in the common Lustre format into a record, one operation per line:
+// "{name} {samples} samples [{unit}] {minimum} {maximum} {sum} {sum of squares}", where the last four fields are
+// optional. The snapshot_time line sets the record's snapshot time.
+func parseStatsRecord(r io.Reader) (record jobStatsRecord, err error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		if fields[0] == "snapshot_time" {
+			record.snapshotTime, err = strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return record, err
+			}
+			continue
+		}
+		if len(fields) < 3 || fields[2] != "samples" {
+			continue
+		}
+		op := jobStatsOperation{name: fields[0]}
+		if len(fields) > 3 {
+			op.unit = strings.Trim(fields[3], "[]")
+		}
+		if op.samples, err = strconv.ParseFloat(fields[1], 64); err != nil {
+			return record, err
+		}
+		values := []*float64{&op.min, &op.max, &op.sum, &op.sumsq}
+		for i := 4; i < len(fields) && i-4 < len(values); i++ {
+			if *values[i-4], err = strconv.ParseFloat(fields[i], 64); err != nil {
+				return record, err
+			}
+		}
+		record.operations = append(record.operations, op)
+	}
+	return record, scanner.Err()
+}
+
+// exportValue is a value of the 'other' client along with the template it belongs to.
+type exportValue struct {
+	metric lustreProcMetric
+	item   lustreStatsMetric
+}
+
+// getExportFileMetrics returns the values of a template from the content of a per-client export file other than 'stats'.
+func getExportFileMetrics(filename string, content string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	switch filename {
+	case exportLdlmStats:
+		record, err := parseStatsRecord(strings.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range record.operations {
+			l := lustreStatsMetric{title: promName, help: helpText, value: op.samples, extraLabel: "operation", extraLabelValue: op.name}
+			if helpText == ldlmLatencyHelp {
+				// Lock latencies are only tracked by Lustre versions that report them in microseconds, as [usec] or [usecs]
+				if op.unit != "usec" && op.unit != "usecs" {
+					continue
+				}
+				l.value = op.sum / 1000000
+			}
+			metricList = append(metricList, l)
+		}
+	case exportOpenFiles:
+		// open_files lists the FID of each open file, one per line
+		var openFiles float64
+		for _, line := range strings.Split(content, "\n") {
+			if strings.TrimSpace(line) != "" {
+				openFiles++
+			}
+		}
+		metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: openFiles})
+	case exportReplyData:
+		keys := map[string]string{
+			replyCountHelp:         "reply_cnt",
+			replyMaximumHelp:       "reply_max",
+			replyReleasedByXIDHelp: "reply_released_by_xid",
+			replyReleasedByTagHelp: "reply_released_by_tag",
+		}
+		value := regexCaptureString("(?m:^"+keys[helpText]+": .*$)", content)
+		if value == "" {
+			return nil, nil
+		}
+		result, err := strconv.ParseFloat(strings.TrimSpace(strings.SplitN(value, ":", 2)[1]), 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: result})
+	}
+	return metricList, nil
+}
+
+// parseExports reads the export files of every client of a target. The most active clients are selected from their
+// 'stats' files, and the values of every given template are emitted for each of them, while the remaining clients are
+// added up into the 'other' client. The number of clients that were folded into the 'other' client comes last.
+func (s *lustreProcfsSource) parseExports(nodeType string, targetPath string, metrics []lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	paths, err := filepath.Glob(filepath.Join(targetPath, exportStats))
+	if err != nil {
+		return err
+	}
+	if paths == nil {
+		return nil
+	}
+	nodeName := filepath.Base(targetPath)
+	fileMetrics := make(map[string][]lustreProcMetric)
+	var statsMetrics []lustreProcMetric
+	for _, metric := range metrics {
+		if metric.filename == exportStats {
+			statsMetrics = append(statsMetrics, metric)
+		} else {
+			fileMetrics[metric.filename] = append(fileMetrics[metric.filename], metric)
+		}
+	}
+
+	emit := func(metric lustreProcMetric, clientNID string, item lustreStatsMetric) {
+		labels := []string{"component", "target", "client_nid"}
+		labelValues := []string{nodeType, nodeName, clientNID}
+		if item.extraLabelValue == "" {
+			handler(metric.metricFunc, labels, labelValues, item.title, item.help, item.value)
+		} else {
+			handler(metric.metricFunc, append(labels, item.extraLabel), append(labelValues, item.extraLabelValue), item.title, item.help, item.value)
+		}
+	}
+	emitStats := func(client jobStatsRecord) error {
+		for _, metric := range statsMetrics {
+			metricList, err := getJobStatsMetrics(client, metric.promName, metric.helpText, metric.hasMultipleVals)
+			if err != nil {
+				return err
+			}
+			for _, item := range metricList {
+				emit(metric, client.jobID, item.lustreStatsMetric)
+			}
+		}
+		return nil
+	}
+
+	selector := newJobStatsSelector(ExportTopN, componentSortBy[nodeType], 0)
+	for _, path := range paths {
+		client, err := readExportStats(path)
+		if err != nil {
+			return err
+		}
+		if !selector.add(client) {
+			continue
+		}
+		if err = emitStats(client); err != nil {
+			return err
+		}
+	}
+	selected := make(map[string]bool)
+	for _, client := range selector.selected() {
+		selected[client.jobID] = true
+		if err = emitStats(client); err != nil {
+			return err
+		}
+	}
+	if selector.topN <= 0 {
+		// Without a limit, every client has already been emitted above
+		for _, path := range paths {
+			selected[filepath.Base(filepath.Dir(path))] = true
+		}
+	}
+
+	for filename, metrics := range fileMetrics {
+		filePaths, err := filepath.Glob(filepath.Join(targetPath, filename))
+		if err != nil {
+			return err
+		}
+		// The values of the clients that weren't selected are added up into the 'other' client
+		var other []exportValue
+		otherIndex := make(map[string]int)
+		for _, path := range filePaths {
+			content, err := ioutil.ReadFile(filepath.Clean(path))
+			if err != nil {
+				return err
+			}
+			clientNID := filepath.Base(filepath.Dir(path))
+			for i, metric := range metrics {
+				metricList, err := getExportFileMetrics(filename, string(content), metric.promName, metric.helpText)
+				if err != nil {
+					return err
+				}
+				for _, item := range metricList {
+					if selected[clientNID] {
+						emit(metric, clientNID, item)
+						continue
+					}
+					key := strconv.Itoa(i) + "/" + item.extraLabelValue
+					if j, ok := otherIndex[key]; ok {
+						other[j].item.value += item.value
+					} else {
+						otherIndex[key] = len(other)
+						other = append(other, exportValue{metric, item})
+					}
+				}
+			}
+		}
+		for _, o := range other {
+			emit(o.metric, jobStatsOtherJobID, o.item)
+		}
+	}
+	handler(s.gaugeMetric, []string{"component", "target"}, []string{nodeType, nodeName}, "exporter_export_stats_suppressed_clients", exportSuppressedHelp, float64(selector.suppressed))
+	return nil
+}
+
+// readExportStats reads the stats file of a single client, named after the client's NID.
+func readExportStats(path string) (client jobStatsRecord, err error) {
+	statsFile, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return client, err
+	}
+	defer statsFile.Close()
+	client, err = parseStatsRecord(statsFile)
+	client.jobID = filepath.Base(filepath.Dir(path))
+	return client, err
+}
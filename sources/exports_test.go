@@ -0,0 +1,138 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseStatsRecord(t *testing.T) {
+	statsFile := `snapshot_time             1510782606.789671326 secs.nsecs
+write_bytes               4298711 samples [bytes] 4096 4194304 16552048697344
+punch                     57 samples [reqs]
+ping                      140 samples [reqs] 1 2 150 190
+`
+	expected := jobStatsRecord{
+		snapshotTime: 1510782606.789671326,
+		operations: []jobStatsOperation{
+			{name: "write_bytes", unit: "bytes", samples: 4298711, min: 4096, max: 4194304, sum: 16552048697344},
+			{name: "punch", unit: "reqs", samples: 57},
+			{name: "ping", unit: "reqs", samples: 140, min: 1, max: 2, sum: 150, sumsq: 190},
+		},
+	}
+	record, err := parseStatsRecord(strings.NewReader(statsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Fatalf("Retrieved an unexpected record. Expected: %+v, Got: %+v", expected, record)
+	}
+
+	if _, err = parseStatsRecord(strings.NewReader("ping abc samples [reqs]\n")); err == nil {
+		t.Fatal("Expected an error for an invalid sample count")
+	}
+}
+
+func TestParseExports(t *testing.T) {
+	defer func(topN int) { ExportTopN = topN }(ExportTopN)
+	ExportTopN = 1
+
+	s := &lustreProcfsSource{}
+	metrics := []lustreProcMetric{
+		newLustreProcMetric(exportStats, "export_stats_total", "ost", "obdfilter/*", statsHelp, true, s.counterMetric),
+	}
+	values := make(map[string]float64)
+	err := s.parseExports("ost", "../proc/fs/lustre/obdfilter/lustrefs-OST0000", metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+		values[name+"/"+strings.Join(labelValues, "/")] = value
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]float64{
+		"export_stats_total/ost/lustrefs-OST0000/172.20.20.4@o2ib/punch":    57,
+		"export_stats_total/ost/lustrefs-OST0000/172.20.20.4@o2ib/preprw":   4298711,
+		"export_stats_total/ost/lustrefs-OST0000/172.20.20.4@o2ib/commitrw": 4298710,
+		"export_stats_total/ost/lustrefs-OST0000/172.20.20.4@o2ib/ping":     140,
+		"export_stats_total/ost/lustrefs-OST0000/other/create":              2,
+		"export_stats_total/ost/lustrefs-OST0000/other/statfs":              35359,
+		"export_stats_total/ost/lustrefs-OST0000/other/ping":                1,
+		"exporter_export_stats_suppressed_clients/ost/lustrefs-OST0000":     1,
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("Retrieved unexpected export metrics. Expected: %v, Got: %v", expected, values)
+	}
+
+	// The open files of the clients that weren't selected are added up into the 'other' client
+	ExportTopN = 2
+	metrics = []lustreProcMetric{
+		newLustreProcMetric(exportOpenFiles, "export_open_files", "mdt", "mdt/*", openFilesHelp, false, s.gaugeMetric),
+	}
+	values = make(map[string]float64)
+	err = s.parseExports("mdt", "../proc/fs/lustre/mdt/lustrefs-MDT0000", metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+		values[name+"/"+strings.Join(labelValues, "/")] = value
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 4 || values["export_open_files/mdt/lustrefs-MDT0000/172.20.20.4@o2ib"] != 1 || values["export_open_files/mdt/lustrefs-MDT0000/other"] != 0 || values["exporter_export_stats_suppressed_clients/mdt/lustrefs-MDT0000"] != 2 {
+		t.Fatalf("Retrieved unexpected export metrics: %v", values)
+	}
+}
+
+func TestGetExportFileMetrics(t *testing.T) {
+	ldlmStats := `snapshot_time             1510781853.008964699 secs.nsecs
+ldlm_enqueue              28 samples [usec] 10 500 2000000 1000000000
+ldlm_bl_callback          3 samples [usecs] 100 300000 500000 100000000000
+ldlm_cancel               14 samples [reqs]
+`
+	replyData := `reply_cnt: 1
+reply_max: 8
+reply_released_by_xid: 65
+reply_released_by_tag: 11
+
+reply_cnt: 0
+reply_max: 0
+`
+	testCases := []struct {
+		filename string
+		content  string
+		helpText string
+		expected []lustreStatsMetric
+	}{
+		{exportLdlmStats, ldlmStats, ldlmRequestsHelp, []lustreStatsMetric{
+			{"test", ldlmRequestsHelp, 28, "operation", "ldlm_enqueue"},
+			{"test", ldlmRequestsHelp, 3, "operation", "ldlm_bl_callback"},
+			{"test", ldlmRequestsHelp, 14, "operation", "ldlm_cancel"},
+		}},
+		{exportLdlmStats, ldlmStats, ldlmLatencyHelp, []lustreStatsMetric{
+			{"test", ldlmLatencyHelp, 2, "operation", "ldlm_enqueue"},
+			{"test", ldlmLatencyHelp, 0.5, "operation", "ldlm_bl_callback"},
+		}},
+		{exportOpenFiles, "[0x200000bd0:0x1:0x0]\n[0x200000bd0:0x2:0x0]\n", openFilesHelp, []lustreStatsMetric{{"test", openFilesHelp, 2, "", ""}}},
+		{exportOpenFiles, "", openFilesHelp, []lustreStatsMetric{{"test", openFilesHelp, 0, "", ""}}},
+		{exportReplyData, replyData, replyMaximumHelp, []lustreStatsMetric{{"test", replyMaximumHelp, 8, "", ""}}},
+		{exportReplyData, replyData, replyReleasedByTagHelp, []lustreStatsMetric{{"test", replyReleasedByTagHelp, 11, "", ""}}},
+	}
+	for _, testCase := range testCases {
+		metricList, err := getExportFileMetrics(testCase.filename, testCase.content, "test", testCase.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, testCase.expected) {
+			t.Fatalf("Retrieved unexpected metrics for %q. Expected: %v, Got: %v", testCase.helpText, testCase.expected, metricList)
+		}
+	}
+}
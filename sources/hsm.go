@@ -0,0 +1,259 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	hsmControlFile        string = "hsm_control"
+	hsmActionsFile        string = "hsm/actions"
+	hsmAgentsFile         string = "hsm/agents"
+	hsmActiveRequestsFile string = "hsm/active_requests"
+	hsmPolicyFile         string = "hsm/policy"
+
+	// Help text dedicated to the HSM coordinator files
+	hsmCoordinatorStateHelp string = "Current state of the HSM coordinator: 1 for the reported state, 0 for the others."
+	hsmActionsHelp          string = "Number of HSM actions in the coordinator log, by action and status."
+	hsmActiveRequestsHelp   string = "Number of HSM requests currently being handled by copytool agents."
+	hsmAgentsHelp           string = "Number of copytool agents registered for the HSM archive ID. Agents serving any archive are counted under 'any'."
+	hsmAgentCurrentHelp     string = "Number of HSM requests currently being handled by the copytool agent."
+	hsmAgentRequestsHelp    string = "Total number of HSM requests handled by the copytool agent, by result."
+	hsmPolicyHelp           string = "Whether the HSM coordinator policy is set: 1 if it is, 0 otherwise."
+)
+
+var (
+	// HsmEnabled specifies whether to collect HSM coordinator metrics
+	HsmEnabled string
+
+	// hsmCoordinatorStates, hsmActions and hsmActionStatuses are always exported, so that each of them can be
+	// alerted on
+	hsmCoordinatorStates = []string{"init", "enabled", "disabled", "stopping", "stopped"}
+	hsmActions           = []string{"archive", "restore", "remove", "cancel"}
+	hsmActionStatuses    = []string{"waiting", "started", "succeed", "failed", "canceled"}
+
+	// hsmFieldPattern matches the 'key=value' and 'key=[value]' fields of the lines of the HSM coordinator files
+	hsmFieldPattern = regexp.MustCompile(`(\S+?)=(\[[^\]]*\]|\S*)`)
+
+	// hsmFiles are the HSM coordinator files whose values carry labels of their own
+	hsmFiles = map[string]bool{
+		hsmControlFile:        true,
+		hsmActionsFile:        true,
+		hsmAgentsFile:         true,
+		hsmActiveRequestsFile: true,
+		hsmPolicyFile:         true,
+	}
+)
+
+// hsmAgent is a copytool agent of the 'hsm/agents' file.
+type hsmAgent struct {
+	uuid       string
+	archiveIDs []string
+	current    float64
+	ok         float64
+	errors     float64
+}
+
+// parseHSMRecord reads the fields of a line of the 'hsm/actions', 'hsm/active_requests' or 'hsm/agents' files, such
+// as 'fid=[0x200000400:0x1:0x0] action=ARCHIVE archive#=1 status=WAITING data=[]'.
+func parseHSMRecord(line string) map[string]string {
+	fields := make(map[string]string)
+	for _, match := range hsmFieldPattern.FindAllStringSubmatch(line, -1) {
+		if _, exists := fields[match[1]]; !exists {
+			fields[match[1]] = match[2]
+		}
+	}
+	return fields
+}
+
+// getHSMActionMetrics counts the records of the coordinator log by action and status.
+func getHSMActionMetrics(content string) (metricList []labeledMetric) {
+	counts := make(map[[2]string]float64)
+	actions := append([]string{}, hsmActions...)
+	statuses := append([]string{}, hsmActionStatuses...)
+	knownActions := make(map[string]bool)
+	for _, action := range actions {
+		knownActions[action] = true
+	}
+	knownStatuses := make(map[string]bool)
+	for _, status := range statuses {
+		knownStatuses[status] = true
+	}
+	for _, line := range strings.Split(content, "\n") {
+		fields := parseHSMRecord(line)
+		action, status := strings.ToLower(fields["action"]), strings.ToLower(fields["status"])
+		if action == "" || status == "" {
+			continue
+		}
+		// Actions and statuses unknown to this exporter, such as the NOOP action, are exported as they come
+		if !knownActions[action] {
+			knownActions[action] = true
+			actions = append(actions, action)
+		}
+		if !knownStatuses[status] {
+			knownStatuses[status] = true
+			statuses = append(statuses, status)
+		}
+		counts[[2]string{action, status}]++
+	}
+	for _, action := range actions {
+		for _, status := range statuses {
+			metricList = append(metricList, labeledMetric{[]string{"action", "status"}, []string{action, status}, counts[[2]string{action, status}]})
+		}
+	}
+	return metricList
+}
+
+// parseHSMAgents reads the agents of the 'hsm/agents' file, such as:
+//
+//	uuid=a2c5b9d4-ea4e-11e7-80c1-9a214cf093ae archive_id=1,2 requests=[current:0 ok:12 errors:1]
+func parseHSMAgents(content string) (agents []hsmAgent, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := parseHSMRecord(line)
+		if fields["uuid"] == "" {
+			continue
+		}
+		agent := hsmAgent{uuid: fields["uuid"]}
+		for _, id := range strings.Split(fields["archive_id"], ",") {
+			if id = strings.ToLower(strings.TrimSpace(id)); id != "" {
+				agent.archiveIDs = append(agent.archiveIDs, id)
+			}
+		}
+		for _, counter := range strings.Fields(strings.Trim(fields["requests"], "[]")) {
+			keyValue := strings.SplitN(counter, ":", 2)
+			if len(keyValue) != 2 {
+				continue
+			}
+			value, err := strconv.ParseFloat(keyValue[1], 64)
+			if err != nil {
+				return nil, err
+			}
+			switch keyValue[0] {
+			case "current":
+				agent.current = value
+			case "ok":
+				agent.ok = value
+			case "errors":
+				agent.errors = value
+			}
+		}
+		agents = append(agents, agent)
+	}
+	return agents, nil
+}
+
+func getHSMAgentMetrics(agents []hsmAgent, helpText string) (metricList []labeledMetric) {
+	switch helpText {
+	case hsmAgentsHelp:
+		counts := make(map[string]float64)
+		for _, agent := range agents {
+			for _, id := range agent.archiveIDs {
+				counts[id]++
+			}
+		}
+		ids := make([]string, 0, len(counts))
+		for id := range counts {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			metricList = append(metricList, labeledMetric{[]string{"archive_id"}, []string{id}, counts[id]})
+		}
+	case hsmAgentCurrentHelp:
+		for _, agent := range agents {
+			metricList = append(metricList, labeledMetric{[]string{"agent"}, []string{agent.uuid}, agent.current})
+		}
+	case hsmAgentRequestsHelp:
+		for _, agent := range agents {
+			metricList = append(metricList,
+				labeledMetric{[]string{"agent", "result"}, []string{agent.uuid, "ok"}, agent.ok},
+				labeledMetric{[]string{"agent", "result"}, []string{agent.uuid, "errors"}, agent.errors},
+			)
+		}
+	}
+	return metricList
+}
+
+// getHSMPolicyMetrics reads the 'hsm/policy' file, which lists every coordinator policy and puts the set ones between
+// brackets, such as 'NonBlockingRestore [NoRetryAction]'.
+func getHSMPolicyMetrics(content string) (metricList []labeledMetric) {
+	for _, policy := range strings.Fields(content) {
+		value := 0.0
+		if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+			value = 1
+		}
+		metricList = append(metricList, labeledMetric{[]string{"policy"}, []string{strings.Trim(policy, "[]")}, value})
+	}
+	return metricList
+}
+
+func getHSMControlMetrics(content string) (metricList []labeledMetric) {
+	state := strings.TrimSpace(content)
+	known := false
+	for _, s := range hsmCoordinatorStates {
+		value := 0.0
+		if s == state {
+			value = 1
+			known = true
+		}
+		metricList = append(metricList, labeledMetric{[]string{"state"}, []string{s}, value})
+	}
+	if !known && state != "" {
+		metricList = append(metricList, labeledMetric{[]string{"state"}, []string{state}, 1})
+	}
+	return metricList
+}
+
+// parseHSMFile emits the values of a template from a file of the HSM coordinator of an MDT.
+func (s *lustreProcfsSource) parseHSMFile(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, strings.Count(metric.filename, "/"))
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	labels := []string{"component", "target"}
+	labelValues := []string{nodeType, nodeName}
+	switch metric.filename {
+	case hsmControlFile:
+		emitLabeledMetrics(metric, labels, labelValues, getHSMControlMetrics(string(content)), handler)
+	case hsmActionsFile:
+		emitLabeledMetrics(metric, labels, labelValues, getHSMActionMetrics(string(content)), handler)
+	case hsmAgentsFile:
+		agents, err := parseHSMAgents(string(content))
+		if err != nil {
+			return err
+		}
+		emitLabeledMetrics(metric, labels, labelValues, getHSMAgentMetrics(agents, metric.helpText), handler)
+	case hsmActiveRequestsFile:
+		requests := 0.0
+		for _, line := range strings.Split(string(content), "\n") {
+			if parseHSMRecord(line)["action"] != "" {
+				requests++
+			}
+		}
+		handler(metric.metricFunc, labels, labelValues, metric.promName, metric.helpText, requests)
+	case hsmPolicyFile:
+		emitLabeledMetrics(metric, labels, labelValues, getHSMPolicyMetrics(string(content)), handler)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetHSMActionMetrics(t *testing.T) {
+	actions := `lrh=[type=10680000 len=136 idx=1/3] fid=[0x200000400:0x1:0x0] dfid=[0x200000400:0x1:0x0] compound/cookie=0x5a4c1e4f/0x5a4c1e4f action=ARCHIVE archive#=1 flags=0x0 extent=0x0-0xffffffffffffffff gid=0x0 datalen=0 status=WAITING data=[]
+lrh=[type=10680000 len=136 idx=1/6] fid=[0x200000400:0x2:0x0] dfid=[0x200000400:0x2:0x0] compound/cookie=0x5a4c1e50/0x5a4c1e50 action=ARCHIVE archive#=1 flags=0x0 extent=0x0-0xffffffffffffffff gid=0x0 datalen=0 status=WAITING data=[]
+lrh=[type=10680000 len=136 idx=1/9] fid=[0x200000400:0x3:0x0] dfid=[0x200000400:0x3:0x0] compound/cookie=0x5a4c1e51/0x5a4c1e51 action=RESTORE archive#=2 flags=0x0 extent=0x0-0xffffffffffffffff gid=0x0 datalen=0 status=FAILED data=[]
+lrh=[type=10680000 len=136 idx=1/12] fid=[0x200000400:0x4:0x0] dfid=[0x200000400:0x4:0x0] compound/cookie=0x5a4c1e52/0x5a4c1e52 action=NOOP archive#=1 flags=0x0 extent=0x0-0xffffffffffffffff gid=0x0 datalen=0 status=SUCCEED data=[]
+`
+	metricList := getHSMActionMetrics(actions)
+	if len(metricList) != (len(hsmActions)+1)*len(hsmActionStatuses) {
+		t.Fatalf("Unexpected number of action metrics: %d", len(metricList))
+	}
+	counts := make(map[string]float64)
+	for _, item := range metricList {
+		counts[item.labelValues[0]+"/"+item.labelValues[1]] = item.value
+	}
+	expected := map[string]float64{"archive/waiting": 2, "restore/failed": 1, "noop/succeed": 1, "remove/started": 0}
+	for key, value := range expected {
+		if counts[key] != value {
+			t.Fatalf("Unexpected count of %s actions: %g, expected %g", key, counts[key], value)
+		}
+	}
+}
+
+func TestParseHSMAgents(t *testing.T) {
+	content := `uuid=a2c5b9d4-ea4e-11e7-80c1-9a214cf093ae archive_id=1,2 requests=[current:1 ok:12 errors:1]
+uuid=b7d0e1f6-ea4e-11e7-80c1-9a214cf093ae archive_id=ANY requests=[current:0 ok:3 errors:0]
+`
+	agents, err := parseHSMAgents(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []hsmAgent{
+		{uuid: "a2c5b9d4-ea4e-11e7-80c1-9a214cf093ae", archiveIDs: []string{"1", "2"}, current: 1, ok: 12, errors: 1},
+		{uuid: "b7d0e1f6-ea4e-11e7-80c1-9a214cf093ae", archiveIDs: []string{"any"}, current: 0, ok: 3, errors: 0},
+	}
+	if !reflect.DeepEqual(agents, expected) {
+		t.Fatalf("Unexpected agents: %+v, expected %+v", agents, expected)
+	}
+
+	expectedAgents := []labeledMetric{
+		{[]string{"archive_id"}, []string{"1"}, 1},
+		{[]string{"archive_id"}, []string{"2"}, 1},
+		{[]string{"archive_id"}, []string{"any"}, 1},
+	}
+	if metricList := getHSMAgentMetrics(agents, hsmAgentsHelp); !reflect.DeepEqual(metricList, expectedAgents) {
+		t.Fatalf("Unexpected agent metrics: %+v, expected %+v", metricList, expectedAgents)
+	}
+	requests := getHSMAgentMetrics(agents, hsmAgentRequestsHelp)
+	if len(requests) != 4 || requests[1].labelValues[1] != "errors" || requests[1].value != 1 {
+		t.Fatalf("Unexpected agent request metrics: %+v", requests)
+	}
+}
+
+func TestGetHSMPolicyMetrics(t *testing.T) {
+	expected := []labeledMetric{
+		{[]string{"policy"}, []string{"NonBlockingRestore"}, 0},
+		{[]string{"policy"}, []string{"NoRetryAction"}, 1},
+	}
+	if metricList := getHSMPolicyMetrics("NonBlockingRestore [NoRetryAction]\n"); !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected policy metrics: %+v, expected %+v", metricList, expected)
+	}
+}
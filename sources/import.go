@@ -0,0 +1,312 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	importFile      string = "import"
+	importStateFile string = "state"
+
+	// Help text dedicated to the 'import' file
+	importStateHelp              string = "Current state of the import: 1 for the reported state, 0 for the others."
+	importConnectionHelp         string = "Server NID the import is currently connected to, in the 'nid' label."
+	importConnectionAttemptsHelp string = "Total number of attempts to connect the import to its target."
+	importInflightHelp           string = "Number of RPCs currently in flight on the import."
+	importUnregisteringHelp      string = "Number of RPCs currently being unregistered on the import."
+	importTimeoutsHelp           string = "Number of RPCs that timed out on the import."
+	importAverageWaitHelp        string = "Average time in seconds RPCs waited for a reply on the import."
+	importServiceEstimateHelp    string = "Adaptive timeout estimate in seconds of the time the target takes to serve an RPC."
+	importNetworkEstimateHelp    string = "Adaptive timeout estimate in seconds of the network latency to the target."
+
+	// Help text dedicated to the 'state' file
+	importTransitionsHelp string = "Total number of import state transitions observed in the state history since the exporter started."
+	importEvictionsHelp   string = "Total number of evictions observed in the import state history since the exporter started."
+	importEvictedState    string = "evicted"
+)
+
+var (
+	// ImportEnabled specifies whether to collect the connection metrics of the client, osp and lwp imports
+	ImportEnabled string
+
+	// importStates are always exported by lustre_import_state, so that each of them can be alerted on
+	importStates = []string{"closed", "new", "disconn", "connecting", "replay", "replay_locks", "replay_wait", "recover", "full", "evicted", "idle"}
+
+	// importStateHistoryPattern matches the ' - [ {timestamp}, {state} ]' entries of a state history
+	importStateHistoryPattern = regexp.MustCompile(`^\s*-\s*\[\s*([0-9]+),\s*([A-Za-z_]+)\s*\]`)
+)
+
+// parseImportFile reads the YAML-like content of an import file into a flat map. Nested keys are joined to their
+// parent with a dot, such as 'rpcs.inflight', and the brackets of lists are stripped.
+func parseImportFile(content string) map[string]string {
+	fields := make(map[string]string)
+	// sections holds the key and indentation of each enclosing section
+	type section struct {
+		key    string
+		indent int
+	}
+	var sections []section
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		keyValue := strings.SplitN(trimmed, ":", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		for len(sections) > 0 && sections[len(sections)-1].indent >= indent {
+			sections = sections[:len(sections)-1]
+		}
+		key := strings.TrimSpace(keyValue[0])
+		value := strings.TrimSpace(keyValue[1])
+		if value == "" {
+			sections = append(sections, section{key, indent})
+			continue
+		}
+		var path []string
+		for i, enclosing := range sections {
+			// The top-level 'import' section is implied by the file name
+			if i == 0 && enclosing.key == importFile {
+				continue
+			}
+			path = append(path, enclosing.key)
+		}
+		fields[strings.Join(append(path, key), ".")] = strings.Trim(value, "[] ")
+	}
+	return fields
+}
+
+func readImportFile(path string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return parseImportFile(string(content)), nil
+}
+
+func getImportMetrics(fields map[string]string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	switch helpText {
+	case importStateHelp:
+		state := strings.ToLower(fields["state"])
+		known := false
+		for _, s := range importStates {
+			value := 0.0
+			if s == state {
+				value = 1
+				known = true
+			}
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "state", extraLabelValue: s})
+		}
+		if !known && state != "" {
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: 1, extraLabel: "state", extraLabelValue: state})
+		}
+		return metricList, nil
+	case importConnectionHelp:
+		nid := fields["connection.current_connection"]
+		if nid == "" {
+			return nil, nil
+		}
+		return []lustreStatsMetric{{title: promName, help: helpText, value: 1, extraLabel: "nid", extraLabelValue: nid}}, nil
+	}
+
+	// fieldMap matches the given helpText value with the key holding its value and the divisor converting it to
+	// the unit of the metric
+	fieldMap := map[string]struct {
+		key     string
+		divisor float64
+	}{
+		importConnectionAttemptsHelp: {"connection.connection_attempts", 1},
+		importInflightHelp:           {"rpcs.inflight", 1},
+		importUnregisteringHelp:      {"rpcs.unregistering", 1},
+		importTimeoutsHelp:           {"rpcs.timeouts", 1},
+		importAverageWaitHelp:        {"rpcs.avg_waittime", 1000000},
+		importServiceEstimateHelp:    {"service_estimates.services", 1},
+		importNetworkEstimateHelp:    {"service_estimates.network", 1},
+	}
+	field, exists := fieldMap[helpText]
+	if !exists {
+		return nil, nil
+	}
+	// Values such as '408 usec' or '1 sec' are followed by their unit
+	value := strings.Fields(fields[field.key])
+	if len(value) == 0 {
+		return nil, nil
+	}
+	result, err := strconv.ParseFloat(value[0], 64)
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: result / field.divisor}}, nil
+}
+
+type importStateEntry struct {
+	timestamp int64
+	state     string
+}
+
+// parseImportStateHistory returns the entries of the state_history list of a 'state' or 'import' file, oldest first.
+func parseImportStateHistory(content string) (entries []importStateEntry) {
+	for _, line := range strings.Split(content, "\n") {
+		match := importStateHistoryPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, importStateEntry{timestamp, strings.ToLower(match[2])})
+	}
+	return entries
+}
+
+// readImportStateHistory reads the state history from the 'state' file of a device, or from the state_history list
+// of its 'import' file on Lustre versions without a 'state' file.
+func readImportStateHistory(path string) ([]importStateEntry, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if os.IsNotExist(err) {
+		content, err = ioutil.ReadFile(filepath.Join(filepath.Dir(path), importFile))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseImportStateHistory(string(content)), nil
+}
+
+type importTransition struct {
+	from string
+	to   string
+}
+
+// importStateCounters holds the last state history read for an import, and the transitions counted from it so far.
+type importStateCounters struct {
+	history     []importStateEntry
+	transitions map[importTransition]float64
+	evictions   float64
+}
+
+// importStateTracker counts the state transitions of every import across scrapes. Lustre only keeps the last few
+// entries of each state history, so the entries already seen are recognized by their timestamps and order, and only
+// the entries appended since the previous scrape are counted. The history found at the first scrape of an import is
+// only used as a starting point, so that restarting the exporter doesn't count the same transitions again.
+type importStateTracker struct {
+	mu      sync.Mutex
+	imports map[string]*importStateCounters
+}
+
+func newImportStateTracker() *importStateTracker {
+	return &importStateTracker{imports: make(map[string]*importStateCounters)}
+}
+
+// appendedEntries returns the entries of current that come after the longest suffix of previous it starts with.
+// Entries older than the last one of previous are dropped in any case.
+func appendedEntries(previous []importStateEntry, current []importStateEntry) []importStateEntry {
+	for k := range previous {
+		overlap := previous[k:]
+		if len(overlap) > len(current) {
+			continue
+		}
+		matches := true
+		for i := range overlap {
+			if overlap[i] != current[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return current[len(overlap):]
+		}
+	}
+	if len(previous) == 0 {
+		return current
+	}
+	last := previous[len(previous)-1].timestamp
+	for i, entry := range current {
+		if entry.timestamp >= last {
+			return current[i:]
+		}
+	}
+	return nil
+}
+
+// update counts the transitions appended to the state history of an import, and returns a copy of its counters.
+func (t *importStateTracker) update(key string, history []importStateEntry) importStateCounters {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counters, exists := t.imports[key]
+	if !exists {
+		counters = &importStateCounters{history: history, transitions: make(map[importTransition]float64)}
+		t.imports[key] = counters
+	} else {
+		previous := counters.history
+		for _, entry := range appendedEntries(previous, history) {
+			if entry.state == importEvictedState {
+				counters.evictions++
+			}
+			if len(previous) > 0 {
+				counters.transitions[importTransition{previous[len(previous)-1].state, entry.state}]++
+			}
+			previous = append(previous[:len(previous):len(previous)], entry)
+		}
+		counters.history = history
+	}
+	transitions := make(map[importTransition]float64, len(counters.transitions))
+	for transition, count := range counters.transitions {
+		transitions[transition] = count
+	}
+	return importStateCounters{history: history, transitions: transitions, evictions: counters.evictions}
+}
+
+// parseImportStates counts the state transitions of the import of a device, and emits the values of every given
+// template for it.
+func (s *lustreProcfsSource) parseImportStates(nodeType string, importPath string, metrics []lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(importPath, 0)
+	if err != nil {
+		return err
+	}
+	history, err := readImportStateHistory(filepath.Join(filepath.Dir(importPath), importStateFile))
+	if err != nil {
+		return err
+	}
+	counters := s.importStates.update(nodeType+"/"+nodeName, history)
+	for _, metric := range metrics {
+		switch metric.helpText {
+		case importTransitionsHelp:
+			transitions := make([]importTransition, 0, len(counters.transitions))
+			for transition := range counters.transitions {
+				transitions = append(transitions, transition)
+			}
+			sort.Slice(transitions, func(i, j int) bool {
+				if transitions[i].from != transitions[j].from {
+					return transitions[i].from < transitions[j].from
+				}
+				return transitions[i].to < transitions[j].to
+			})
+			for _, transition := range transitions {
+				handler(metric.metricFunc, []string{"component", "target", "from", "to"}, []string{nodeType, nodeName, transition.from, transition.to}, metric.promName, metric.helpText, counters.transitions[transition])
+			}
+		case importEvictionsHelp:
+			handler(metric.metricFunc, []string{"component", "target"}, []string{nodeType, nodeName}, metric.promName, metric.helpText, counters.evictions)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testImportFile = `import:
+    name: lustrefs-OST0000-osc-ffff88105db50000
+    target: lustrefs-OST0000_UUID
+    state: REPLAY_LOCKS
+    connect_flags: [ write_grant, server_lock ]
+    connect_data:
+       flags: 0x20404af0e3440478
+       instance: 3
+    import_flags: [ replayable, pingable, connect_tried ]
+    connection:
+       failover_nids: [ 172.20.20.5@o2ib, 172.20.20.6@o2ib ]
+       current_connection: 172.20.20.6@o2ib
+       connection_attempts: 4
+    rpcs:
+       inflight: 8
+       unregistering: 0
+       timeouts: 3
+       avg_waittime: 6054 usec
+    service_estimates:
+       services: 1 sec
+       network: 2 sec
+`
+
+func TestParseImportFile(t *testing.T) {
+	fields := parseImportFile(testImportFile)
+	expected := map[string]string{
+		"name":                           "lustrefs-OST0000-osc-ffff88105db50000",
+		"target":                         "lustrefs-OST0000_UUID",
+		"state":                          "REPLAY_LOCKS",
+		"connect_flags":                  "write_grant, server_lock",
+		"connect_data.flags":             "0x20404af0e3440478",
+		"connect_data.instance":          "3",
+		"import_flags":                   "replayable, pingable, connect_tried",
+		"connection.failover_nids":       "172.20.20.5@o2ib, 172.20.20.6@o2ib",
+		"connection.current_connection":  "172.20.20.6@o2ib",
+		"connection.connection_attempts": "4",
+		"rpcs.inflight":                  "8",
+		"rpcs.unregistering":             "0",
+		"rpcs.timeouts":                  "3",
+		"rpcs.avg_waittime":              "6054 usec",
+		"service_estimates.services":     "1 sec",
+		"service_estimates.network":      "2 sec",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("Retrieved unexpected fields. Expected: %v, Got: %v", expected, fields)
+	}
+}
+
+func TestGetImportMetrics(t *testing.T) {
+	fields := parseImportFile(testImportFile)
+	testCases := []struct {
+		helpText string
+		expected []lustreStatsMetric
+	}{
+		{importConnectionHelp, []lustreStatsMetric{{"test", importConnectionHelp, 1, "nid", "172.20.20.6@o2ib"}}},
+		{importConnectionAttemptsHelp, []lustreStatsMetric{{"test", importConnectionAttemptsHelp, 4, "", ""}}},
+		{importTimeoutsHelp, []lustreStatsMetric{{"test", importTimeoutsHelp, 3, "", ""}}},
+		{importAverageWaitHelp, []lustreStatsMetric{{"test", importAverageWaitHelp, 0.006054, "", ""}}},
+		{importNetworkEstimateHelp, []lustreStatsMetric{{"test", importNetworkEstimateHelp, 2, "", ""}}},
+	}
+	for _, testCase := range testCases {
+		metricList, err := getImportMetrics(fields, "test", testCase.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, testCase.expected) {
+			t.Fatalf("Retrieved unexpected metrics for %q. Expected: %v, Got: %v", testCase.helpText, testCase.expected, metricList)
+		}
+	}
+
+	metricList, err := getImportMetrics(fields, "test", importStateHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != len(importStates) {
+		t.Fatalf("Expected one state metric per known state, got %v", metricList)
+	}
+	for _, metric := range metricList {
+		if (metric.extraLabelValue == "replay_locks") != (metric.value == 1) {
+			t.Fatalf("Retrieved an unexpected value for state %q: %v", metric.extraLabelValue, metric.value)
+		}
+	}
+
+	// Fields without a value are skipped
+	metricList, err = getImportMetrics(map[string]string{"rpcs.avg_waittime": "", "rpcs.timeouts": "  "}, "test", importAverageWaitHelp)
+	if err != nil || len(metricList) != 0 {
+		t.Fatalf("Retrieved unexpected metrics for an empty field: %v, %v", metricList, err)
+	}
+	metricList, err = getImportMetrics(map[string]string{"rpcs.timeouts": "  "}, "test", importTimeoutsHelp)
+	if err != nil || len(metricList) != 0 {
+		t.Fatalf("Retrieved unexpected metrics for a blank field: %v, %v", metricList, err)
+	}
+}
+
+func TestImportStateTracker(t *testing.T) {
+	history := parseImportStateHistory(`current_state: FULL
+state_history:
+ - [ 1510605413, DISCONN ]
+ - [ 1510605413, CONNECTING ]
+ - [ 1510605413, FULL ]
+`)
+	if len(history) != 3 || history[2] != (importStateEntry{1510605413, "full"}) {
+		t.Fatalf("Retrieved an unexpected state history: %v", history)
+	}
+
+	tracker := newImportStateTracker()
+	counters := tracker.update("osc/test", history)
+	if len(counters.transitions) != 0 || counters.evictions != 0 {
+		t.Fatalf("Expected the first history to only be used as a starting point, got %+v", counters)
+	}
+
+	// The oldest entry rolled out of the history while an eviction and a reconnection were added
+	history = append(history[1:],
+		importStateEntry{1510605709, "evicted"},
+		importStateEntry{1510605709, "connecting"},
+		importStateEntry{1510605709, "full"},
+	)
+	counters = tracker.update("osc/test", history)
+	expected := map[importTransition]float64{
+		{"full", "evicted"}:       1,
+		{"evicted", "connecting"}: 1,
+		{"connecting", "full"}:    1,
+	}
+	if !reflect.DeepEqual(counters.transitions, expected) || counters.evictions != 1 {
+		t.Fatalf("Retrieved unexpected counters. Expected: %v, Got: %+v", expected, counters)
+	}
+
+	// An unchanged history doesn't count anything again
+	counters = tracker.update("osc/test", history)
+	if !reflect.DeepEqual(counters.transitions, expected) || counters.evictions != 1 {
+		t.Fatalf("Retrieved unexpected counters for an unchanged history: %+v", counters)
+	}
+
+	// When the whole history rolled over between two scrapes, only the newer entries are counted
+	counters = tracker.update("osc/test", []importStateEntry{
+		{1510605700, "full"},
+		{1510605800, "disconn"},
+		{1510605801, "connecting"},
+	})
+	if counters.transitions[importTransition{"full", "disconn"}] != 1 || counters.transitions[importTransition{"disconn", "connecting"}] != 1 {
+		t.Fatalf("Retrieved unexpected counters after the history rolled over: %+v", counters)
+	}
+}
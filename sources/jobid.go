@@ -0,0 +1,132 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// JobIDTemplate is the jobid_name style template used to decode job IDs into labels. When empty, the
+	// template is read from the node's jobid_name file.
+	JobIDTemplate string
+
+	// jobIDVerbs maps each jobid_name format verb to the label it is exported as and the pattern it matches.
+	jobIDVerbs = map[byte]struct {
+		label   string
+		pattern string
+	}{
+		'e': {"executable", `.+?`},
+		'g': {"gid", `[0-9]+`},
+		'h': {"hostname", `.+?`},
+		'H': {"hostname", `.+?`},
+		'j': {"jobid", `.+?`},
+		'p': {"pid", `[0-9]+`},
+		'u': {"uid", `[0-9]+`},
+	}
+)
+
+// jobIDDecoder splits job IDs built from a jobid_name template into one label per format verb.
+// The 'jobid' label is always present: it holds the '%j' portion when the template has one and no
+// unknown verb, and the complete job ID otherwise. Job IDs that don't match the template are passed through unchanged.
+type jobIDDecoder struct {
+	pattern *regexp.Regexp
+	labels  []string
+}
+
+func readJobIDTemplate() string {
+	if JobIDTemplate != "" {
+		return JobIDTemplate
+	}
+	template, err := ioutil.ReadFile(filepath.Join(SysLocation, "fs/lustre/jobid_name"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(template))
+}
+
+func newJobIDDecoder(template string) *jobIDDecoder {
+	d := &jobIDDecoder{labels: []string{"jobid"}}
+	if !strings.Contains(template, "%") {
+		// Without any format verbs there is nothing to decode
+		return d
+	}
+	seen := map[string]bool{}
+	// Unknown verbs aren't exported, so job IDs differing only there would share their labels. The 'jobid' label
+	// then holds the complete job ID rather than its '%j' portion, to keep every job apart.
+	for i := 0; i < len(template)-1; i++ {
+		if template[i] != '%' {
+			continue
+		}
+		i++
+		if _, ok := jobIDVerbs[template[i]]; !ok && template[i] != '%' {
+			seen["jobid"] = true
+		}
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for i := 0; i < len(template); i++ {
+		if template[i] != '%' || i == len(template)-1 {
+			pattern.WriteString(regexp.QuoteMeta(template[i : i+1]))
+			continue
+		}
+		i++
+		verb, ok := jobIDVerbs[template[i]]
+		switch {
+		case template[i] == '%':
+			pattern.WriteString("%")
+		case !ok:
+			// Unknown verbs still consume part of the job ID, but aren't exported
+			pattern.WriteString(`.*?`)
+		case seen[verb.label]:
+			pattern.WriteString("(?:" + verb.pattern + ")")
+		default:
+			seen[verb.label] = true
+			if verb.label != "jobid" {
+				d.labels = append(d.labels, verb.label)
+			}
+			pattern.WriteString("(?P<" + verb.label + ">" + verb.pattern + ")")
+		}
+	}
+	pattern.WriteString("$")
+	d.pattern = regexp.MustCompile(pattern.String())
+	return d
+}
+
+// decode returns the label values for jobID, in the same order as d.labels.
+func (d *jobIDDecoder) decode(jobID string) []string {
+	values := make([]string, len(d.labels))
+	values[0] = jobID
+	if d.pattern == nil {
+		return values
+	}
+	match := d.pattern.FindStringSubmatch(jobID)
+	if match == nil {
+		return values
+	}
+	for i, name := range d.pattern.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		for j, label := range d.labels {
+			if label == name {
+				values[j] = match[i]
+			}
+		}
+	}
+	return values
+}
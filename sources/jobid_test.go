@@ -0,0 +1,53 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJobIDDecoder(t *testing.T) {
+	testCases := []struct {
+		template       string
+		jobID          string
+		expectedLabels []string
+		expectedValues []string
+	}{
+		{"", "dd.0:node-01", []string{"jobid"}, []string{"dd.0:node-01"}},
+		{"procname_uid", "1234", []string{"jobid"}, []string{"1234"}},
+		{"%e.%u", "dd.1000", []string{"jobid", "executable", "uid"}, []string{"dd.1000", "dd", "1000"}},
+		{"%e.%u", "python3.8.1000", []string{"jobid", "executable", "uid"}, []string{"python3.8.1000", "python3.8", "1000"}},
+		{"%e.%u.%H", "cp.500.node-01", []string{"jobid", "executable", "uid", "hostname"}, []string{"cp.500.node-01", "cp", "500", "node-01"}},
+		{"%j", "1234_7", []string{"jobid"}, []string{"1234_7"}},
+		{"%j:%u", "1234_7:1000", []string{"jobid", "uid"}, []string{"1234_7", "1000"}},
+		{"%e.%u", "unknown-format", []string{"jobid", "executable", "uid"}, []string{"unknown-format", "", ""}},
+		{"%e.%u", "dd.root", []string{"jobid", "executable", "uid"}, []string{"dd.root", "", ""}},
+		{"%x-%j", "foo-42", []string{"jobid"}, []string{"foo-42"}},
+		{"%x-%j", "bar-42", []string{"jobid"}, []string{"bar-42"}},
+		{"%x.%u", "foo.1000", []string{"jobid", "uid"}, []string{"foo.1000", "1000"}},
+		{"%%%j", "%42", []string{"jobid"}, []string{"42"}},
+	}
+
+	for _, testCase := range testCases {
+		d := newJobIDDecoder(testCase.template)
+		if !reflect.DeepEqual(d.labels, testCase.expectedLabels) {
+			t.Fatalf("Retrieved unexpected labels for template %q. Expected: %v, Got: %v", testCase.template, testCase.expectedLabels, d.labels)
+		}
+		values := d.decode(testCase.jobID)
+		if !reflect.DeepEqual(values, testCase.expectedValues) {
+			t.Fatalf("Retrieved unexpected values for template %q and job ID %q. Expected: %v, Got: %v", testCase.template, testCase.jobID, testCase.expectedValues, values)
+		}
+	}
+}
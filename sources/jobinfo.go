@@ -0,0 +1,272 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/log"
+)
+
+var (
+	// JobInfoFile is a JSON or CSV file, periodically written by the scheduler, that holds metadata for each job ID
+	JobInfoFile string
+	// JobInfoCommand is a command (such as squeue) that prints metadata for each job ID as CSV
+	JobInfoCommand string
+	// JobInfoRefreshInterval is the interval between two reads of JobInfoFile or runs of JobInfoCommand
+	JobInfoRefreshInterval = time.Minute
+	// JobInfoTTL is how long the metadata of a job is kept after it was last reported by the scheduler
+	JobInfoTTL = 10 * time.Minute
+
+	// jobInfoLabels are the labels added to every job_stats series when job metadata is enabled
+	jobInfoLabels = []string{"user", "account", "partition", "job_name"}
+
+	// runJobInfoCommand runs the job metadata command and returns its output. Tests replace it with a stub.
+	runJobInfoCommand = func(ctx context.Context, command []string) ([]byte, error) {
+		return exec.CommandContext(ctx, command[0], command[1:]...).Output()
+	}
+)
+
+// jobInfo holds the scheduler's metadata for a single job.
+type jobInfo struct {
+	JobID     string `json:"job_id"`
+	User      string `json:"user"`
+	Account   string `json:"account"`
+	Partition string `json:"partition"`
+	JobName   string `json:"job_name"`
+}
+
+// UnmarshalJSON accepts job IDs as strings as well as numbers, such as Slurm's "job_id": 12345.
+func (j *jobInfo) UnmarshalJSON(data []byte) error {
+	type plainJobInfo jobInfo
+	var raw struct {
+		plainJobInfo
+		JobID json.RawMessage `json:"job_id"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*j = jobInfo(raw.plainJobInfo)
+	jobID := strings.TrimSpace(string(raw.JobID))
+	switch {
+	case jobID == "" || jobID == "null":
+		j.JobID = ""
+	case strings.HasPrefix(jobID, `"`):
+		return json.Unmarshal(raw.JobID, &j.JobID)
+	default:
+		var number json.Number
+		if err := json.Unmarshal(raw.JobID, &number); err != nil {
+			return fmt.Errorf("unable to parse job_id %s: %s", jobID, err)
+		}
+		j.JobID = number.String()
+	}
+	return nil
+}
+
+func (j jobInfo) labelValues() []string {
+	return []string{j.User, j.Account, j.Partition, j.JobName}
+}
+
+// jobInfoProvider returns the metadata of every job the scheduler currently knows about.
+type jobInfoProvider interface {
+	jobs() ([]jobInfo, error)
+}
+
+// jobInfoFileProvider reads job metadata from a file. Files ending in '.json' hold an array of objects with
+// the job_id, user, account, partition and job_name keys, while any other file is read as CSV.
+type jobInfoFileProvider struct {
+	path string
+}
+
+func (p jobInfoFileProvider) jobs() ([]jobInfo, error) {
+	data, err := ioutil.ReadFile(filepath.Clean(p.path))
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(p.path, ".json") {
+		var jobs []jobInfo
+		if err = json.Unmarshal(data, &jobs); err != nil {
+			return nil, err
+		}
+		return jobs, nil
+	}
+	return parseJobInfoCSV(bytes.NewReader(data))
+}
+
+// jobInfoCommandProvider runs a command, such as "sh -c squeue ...", and reads its output as CSV.
+type jobInfoCommandProvider struct {
+	command []string
+	timeout time.Duration
+}
+
+func (p jobInfoCommandProvider) jobs() ([]jobInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	output, err := runJobInfoCommand(ctx, p.command)
+	if err != nil {
+		return nil, fmt.Errorf("%q failed: %s", strings.Join(p.command, " "), err)
+	}
+	return parseJobInfoCSV(bytes.NewReader(output))
+}
+
+// parseJobInfoCSV reads records in the job_id,user,account,partition,job_name column order. A header row
+// naming those columns may be used to change the order or to leave some of them out.
+func parseJobInfoCSV(r io.Reader) (jobs []jobInfo, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	columns := map[string]int{"job_id": 0, "user": 1, "account": 2, "partition": 3, "job_name": 4}
+	first := true
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first {
+			first = false
+			if isJobInfoHeader(record) {
+				columns = make(map[string]int)
+				for i, name := range record {
+					columns[strings.TrimSpace(name)] = i
+				}
+				continue
+			}
+		}
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		job := jobInfo{
+			JobID:     field("job_id"),
+			User:      field("user"),
+			Account:   field("account"),
+			Partition: field("partition"),
+			JobName:   field("job_name"),
+		}
+		if job.JobID != "" {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func isJobInfoHeader(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) == "job_id" {
+			return true
+		}
+	}
+	return false
+}
+
+type jobInfoEntry struct {
+	info     jobInfo
+	lastSeen time.Time
+}
+
+// jobInfoCache holds the job metadata most recently returned by a provider. The provider is only ever called
+// from the refresh loop, so looking a job up never waits on the scheduler.
+type jobInfoCache struct {
+	provider jobInfoProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	entries map[string]jobInfoEntry
+}
+
+func newJobInfoCache(provider jobInfoProvider, ttl time.Duration) *jobInfoCache {
+	return &jobInfoCache{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[string]jobInfoEntry),
+	}
+}
+
+// newJobInfoCacheFromFlags returns a running cache for the configured provider, or nil if none is configured.
+func newJobInfoCacheFromFlags() *jobInfoCache {
+	var provider jobInfoProvider
+	if JobInfoCommand != "" {
+		// The command is run by the shell, so that its arguments may be quoted
+		provider = jobInfoCommandProvider{command: []string{"sh", "-c", JobInfoCommand}, timeout: JobInfoRefreshInterval}
+	} else if JobInfoFile != "" {
+		provider = jobInfoFileProvider{path: JobInfoFile}
+	} else {
+		return nil
+	}
+	c := newJobInfoCache(provider, JobInfoTTL)
+	go c.run(JobInfoRefreshInterval)
+	return c
+}
+
+func (c *jobInfoCache) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := c.refresh(); err != nil {
+			log.Errorf("Unable to refresh job metadata: %s", err)
+		}
+		<-ticker.C
+	}
+}
+
+// refresh loads the provider's jobs into the cache and expires the jobs that haven't been reported within the TTL.
+func (c *jobInfoCache) refresh() error {
+	jobs, err := c.provider.jobs()
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, job := range jobs {
+		if job.JobID == "" {
+			continue
+		}
+		c.entries[job.JobID] = jobInfoEntry{info: job, lastSeen: now}
+	}
+	for jobID, entry := range c.entries {
+		if now.Sub(entry.lastSeen) > c.ttl {
+			delete(c.entries, jobID)
+		}
+	}
+	return err
+}
+
+// labelValues returns the values of jobInfoLabels for the given job ID, which are empty for unknown jobs.
+func (c *jobInfoCache) labelValues(jobID string) []string {
+	c.mu.RLock()
+	entry, ok := c.entries[jobID]
+	c.mu.RUnlock()
+	if !ok || c.now().Sub(entry.lastSeen) > c.ttl {
+		return jobInfo{}.labelValues()
+	}
+	return entry.info.labelValues()
+}
@@ -0,0 +1,181 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseJobInfoCSV(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []jobInfo
+	}{
+		{"1234,alice,physics,batch,sim\n1235,bob,chem,debug,\"md, run\"\n",
+			[]jobInfo{{"1234", "alice", "physics", "batch", "sim"}, {"1235", "bob", "chem", "debug", "md, run"}}},
+		{"user,job_id\nalice,1234\n,\n",
+			[]jobInfo{{JobID: "1234", User: "alice"}}},
+		{"1234,alice\n", []jobInfo{{JobID: "1234", User: "alice"}}},
+		{"", nil},
+	}
+
+	for _, testCase := range testCases {
+		jobs, err := parseJobInfoCSV(strings.NewReader(testCase.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(jobs, testCase.expected) {
+			t.Fatalf("Retrieved unexpected jobs for %q. Expected: %v, Got: %v", testCase.input, testCase.expected, jobs)
+		}
+	}
+}
+
+func TestJobInfoFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jobinfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	path := dir + "/jobs.json"
+	err = ioutil.WriteFile(path, []byte(`[{"job_id": "1234", "user": "alice", "account": "physics", "partition": "batch", "job_name": "sim"}]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs, err := jobInfoFileProvider{path: path}.jobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []jobInfo{{"1234", "alice", "physics", "batch", "sim"}}
+	if !reflect.DeepEqual(jobs, expected) {
+		t.Fatalf("Retrieved unexpected jobs. Expected: %v, Got: %v", expected, jobs)
+	}
+
+	// Schedulers such as Slurm write numeric job IDs
+	err = ioutil.WriteFile(path, []byte(`[{"job_id": 12345, "user": "bob"}, {"job_id": "1234_7", "user": "carol"}]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs, err = jobInfoFileProvider{path: path}.jobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []jobInfo{{JobID: "12345", User: "bob"}, {JobID: "1234_7", User: "carol"}}
+	if !reflect.DeepEqual(jobs, expected) {
+		t.Fatalf("Retrieved unexpected jobs. Expected: %v, Got: %v", expected, jobs)
+	}
+
+	err = ioutil.WriteFile(path, []byte(`[{"job_id": true}]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = (jobInfoFileProvider{path: path}).jobs(); err == nil {
+		t.Fatal("Expected an error for a boolean job ID")
+	}
+}
+
+func TestJobInfoCommandProvider(t *testing.T) {
+	defer func(run func(context.Context, []string) ([]byte, error)) { runJobInfoCommand = run }(runJobInfoCommand)
+
+	var command []string
+	runJobInfoCommand = func(ctx context.Context, c []string) ([]byte, error) {
+		command = c
+		return []byte("1234,alice,physics,batch,sim\n"), nil
+	}
+	provider := jobInfoCommandProvider{command: strings.Fields("squeue -h -o %i,%u,%a,%P,%j"), timeout: time.Second}
+	jobs, err := provider.jobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(command, []string{"squeue", "-h", "-o", "%i,%u,%a,%P,%j"}) {
+		t.Fatalf("Ran unexpected command: %v", command)
+	}
+	expected := []jobInfo{{"1234", "alice", "physics", "batch", "sim"}}
+	if !reflect.DeepEqual(jobs, expected) {
+		t.Fatalf("Retrieved unexpected jobs. Expected: %v, Got: %v", expected, jobs)
+	}
+
+	runJobInfoCommand = func(ctx context.Context, c []string) ([]byte, error) {
+		return nil, fmt.Errorf("exit status 1")
+	}
+	if _, err = provider.jobs(); err == nil {
+		t.Fatal("Expected an error from a failing command")
+	}
+}
+
+type testJobInfoProvider struct {
+	jobList []jobInfo
+	err     error
+}
+
+func (p *testJobInfoProvider) jobs() ([]jobInfo, error) {
+	return p.jobList, p.err
+}
+
+func TestJobInfoCache(t *testing.T) {
+	now := time.Unix(1000, 0)
+	provider := &testJobInfoProvider{jobList: []jobInfo{{"1234", "alice", "physics", "batch", "sim"}, {User: "bob"}}}
+	c := newJobInfoCache(provider, 10*time.Minute)
+	c.now = func() time.Time { return now }
+
+	empty := []string{"", "", "", ""}
+	if values := c.labelValues("1234"); !reflect.DeepEqual(values, empty) {
+		t.Fatalf("Expected empty metadata before the first refresh, got %v", values)
+	}
+	if err := c.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"alice", "physics", "batch", "sim"}
+	if values := c.labelValues("1234"); !reflect.DeepEqual(values, expected) {
+		t.Fatalf("Retrieved unexpected metadata. Expected: %v, Got: %v", expected, values)
+	}
+	if values := c.labelValues("9999"); !reflect.DeepEqual(values, empty) {
+		t.Fatalf("Expected empty metadata for an unknown job, got %v", values)
+	}
+	if _, ok := c.entries[""]; ok || len(c.entries) != 1 {
+		t.Fatalf("Expected jobs without an ID to be skipped, got %v", c.entries)
+	}
+
+	// A failing provider keeps the known jobs until they expire
+	provider.jobList, provider.err = nil, fmt.Errorf("scheduler unavailable")
+	now = now.Add(5 * time.Minute)
+	if err := c.refresh(); err == nil {
+		t.Fatal("Expected the provider error to be returned")
+	}
+	if values := c.labelValues("1234"); !reflect.DeepEqual(values, expected) {
+		t.Fatalf("Expected metadata to be kept within the TTL, got %v", values)
+	}
+
+	now = now.Add(6 * time.Minute)
+	if values := c.labelValues("1234"); !reflect.DeepEqual(values, empty) {
+		t.Fatalf("Expected metadata to expire after the TTL, got %v", values)
+	}
+	if err := c.refresh(); err == nil {
+		t.Fatal("Expected the provider error to be returned")
+	}
+	if len(c.entries) != 0 {
+		t.Fatalf("Expected expired jobs to be removed, got %v", c.entries)
+	}
+}
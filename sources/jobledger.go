@@ -0,0 +1,287 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/log"
+)
+
+var (
+	// JobLedgerFile is the JSON-lines file completed jobs are appended to. Job tracking is disabled when empty.
+	JobLedgerFile string
+	// JobLedgerMaxSize is the size in bytes at which the ledger file is rotated
+	JobLedgerMaxSize int64 = 100 * 1024 * 1024
+	// JobLedgerMaxBackups is the number of rotated ledger files kept next to the current one
+	JobLedgerMaxBackups = 5
+	// JobLedgerRecent is the number of completed jobs served by CompletedJobsHandler
+	JobLedgerRecent = 1000
+
+	// jobLedger is set by OpenJobLedger, and is nil while job tracking is disabled
+	jobLedger *jobCompletionLedger
+)
+
+// jobCompletion is the ledger record of a job that disappeared from a target's job_stats. The first and last seen
+// times come from the job's start_time and snapshot_time when Lustre reports them, and from the scrape times otherwise.
+type jobCompletion struct {
+	JobID      string             `json:"job_id"`
+	Component  string             `json:"component"`
+	Target     string             `json:"target"`
+	FirstSeen  time.Time          `json:"first_seen"`
+	LastSeen   time.Time          `json:"last_seen"`
+	ReadBytes  float64            `json:"read_bytes"`
+	WriteBytes float64            `json:"write_bytes"`
+	Operations map[string]float64 `json:"operations"`
+}
+
+func newJobCompletion(component string, target string, job jobStatsRecord, firstScrape time.Time, lastScrape time.Time) jobCompletion {
+	c := jobCompletion{
+		JobID:      job.jobID,
+		Component:  component,
+		Target:     target,
+		FirstSeen:  firstScrape,
+		LastSeen:   lastScrape,
+		Operations: make(map[string]float64, len(job.operations)),
+	}
+	if job.startTime > 0 {
+		c.FirstSeen = time.Unix(int64(job.startTime), 0)
+	}
+	if job.snapshotTime > 0 {
+		c.LastSeen = time.Unix(int64(job.snapshotTime), 0)
+	}
+	for _, op := range job.operations {
+		switch op.name {
+		case "read_bytes":
+			c.ReadBytes = op.sum
+		case "write_bytes":
+			c.WriteBytes = op.sum
+		}
+		c.Operations[op.name] = op.samples
+	}
+	return c
+}
+
+// trackedJob is the latest job_stats record of a job along with the scrape times it was first and last seen at.
+type trackedJob struct {
+	record      jobStatsRecord
+	firstScrape time.Time
+	lastScrape  time.Time
+}
+
+// trackedTarget holds the jobs of a target's latest job_stats along with the time they were read at.
+type trackedTarget struct {
+	component string
+	target    string
+	jobs      map[string]trackedJob
+	updated   time.Time
+}
+
+// jobCompletionLedger tracks the jobs of every target across scrapes, and records the final counters of the jobs
+// that disappear from a target's job_stats, or along with their target.
+type jobCompletionLedger struct {
+	writer *jobLedgerWriter
+	now    func() time.Time
+
+	mu      sync.Mutex
+	targets map[string]trackedTarget
+	recent  []jobCompletion
+	limit   int
+}
+
+func newJobCompletionLedger(writer *jobLedgerWriter, limit int) *jobCompletionLedger {
+	return &jobCompletionLedger{
+		writer:  writer,
+		now:     time.Now,
+		targets: make(map[string]trackedTarget),
+		limit:   limit,
+	}
+}
+
+// OpenJobLedger opens JobLedgerFile and enables job tracking. It does nothing when JobLedgerFile is empty.
+func OpenJobLedger() error {
+	if JobLedgerFile == "" {
+		return nil
+	}
+	writer, err := newJobLedgerWriter(JobLedgerFile, JobLedgerMaxSize, JobLedgerMaxBackups)
+	if err != nil {
+		return err
+	}
+	jobLedger = newJobCompletionLedger(writer, JobLedgerRecent)
+	return nil
+}
+
+// update replaces the jobs tracked for a target with the jobs of its latest job_stats, and records the jobs that
+// are gone. A job whose start_time changed was cleaned up and started again between two scrapes, so its previous
+// run is recorded as well.
+func (l *jobCompletionLedger) update(component string, target string, jobs []jobStatsRecord) {
+	now := l.now()
+	key := component + "/" + target
+	current := make(map[string]trackedJob, len(jobs))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previous := l.targets[key].jobs
+	for _, job := range jobs {
+		tracked, ok := previous[job.jobID]
+		if ok && tracked.record.startTime != job.startTime {
+			l.complete(newJobCompletion(component, target, tracked.record, tracked.firstScrape, tracked.lastScrape))
+			ok = false
+		}
+		if !ok {
+			tracked.firstScrape = now
+		}
+		tracked.record = job
+		tracked.lastScrape = now
+		current[job.jobID] = tracked
+		delete(previous, job.jobID)
+	}
+	for _, tracked := range previous {
+		l.complete(newJobCompletion(component, target, tracked.record, tracked.firstScrape, tracked.lastScrape))
+	}
+	l.targets[key] = trackedTarget{component: component, target: target, jobs: current, updated: now}
+}
+
+// expire records the jobs of the targets that weren't updated since the given time, such as unmounted or failed
+// over targets, and stops tracking these targets.
+func (l *jobCompletionLedger) expire(since time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, t := range l.targets {
+		if !t.updated.Before(since) {
+			continue
+		}
+		for _, tracked := range t.jobs {
+			l.complete(newJobCompletion(t.component, t.target, tracked.record, tracked.firstScrape, tracked.lastScrape))
+		}
+		delete(l.targets, key)
+	}
+}
+
+// complete appends c to the ledger file and to the recent completions. l.mu must be held.
+func (l *jobCompletionLedger) complete(c jobCompletion) {
+	if l.writer != nil {
+		if err := l.writer.write(c); err != nil {
+			log.Errorf("Unable to write job %s to the job ledger: %s", c.JobID, err)
+		}
+	}
+	if l.limit <= 0 {
+		return
+	}
+	if len(l.recent) >= l.limit {
+		l.recent = append(l.recent[:0], l.recent[len(l.recent)-l.limit+1:]...)
+	}
+	l.recent = append(l.recent, c)
+}
+
+func (l *jobCompletionLedger) completed() []jobCompletion {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]jobCompletion(nil), l.recent...)
+}
+
+// CompletedJobsHandler serves the most recently completed jobs as JSON lines, oldest first.
+func CompletedJobsHandler(w http.ResponseWriter, r *http.Request) {
+	if jobLedger == nil {
+		http.Error(w, "Job tracking is disabled, see --collector.job-ledger.file", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	encoder := json.NewEncoder(w)
+	for _, c := range jobLedger.completed() {
+		if err := encoder.Encode(c); err != nil {
+			log.Errorf("Unable to write completed jobs: %s", err)
+			return
+		}
+	}
+}
+
+// jobLedgerWriter appends JSON lines to a file, rotating it to path.1, path.2, ... once it reaches maxSize bytes.
+type jobLedgerWriter struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+func newJobLedgerWriter(path string, maxSize int64, maxBackups int) (*jobLedgerWriter, error) {
+	w := &jobLedgerWriter{path: filepath.Clean(path), maxSize: maxSize, maxBackups: maxBackups}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *jobLedgerWriter) open() error {
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+func (w *jobLedgerWriter) write(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err = w.rotate(); err != nil {
+			// The current file is still open, and the rotation is tried again on the next write
+			log.Errorf("Unable to rotate the job ledger: %s", err)
+		}
+	}
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	return err
+}
+
+// rotate moves the current file to path.1 and the older backups up by one, then continues in a new file. The current
+// file is only closed once the new one is open, so that the ledger stays writable when the rotation fails.
+func (w *jobLedgerWriter) rotate() error {
+	if w.maxBackups > 0 {
+		for i := w.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(fmt.Sprintf("%s.%d", w.path, i), fmt.Sprintf("%s.%d", w.path, i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(w.path, w.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return err
+	}
+	previous := w.file
+	if err := w.open(); err != nil {
+		return err
+	}
+	return previous.Close()
+}
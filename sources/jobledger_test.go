@@ -0,0 +1,185 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testJobRecord(jobID string, startTime float64, readBytes float64, reads float64) jobStatsRecord {
+	return jobStatsRecord{
+		jobID:        jobID,
+		startTime:    startTime,
+		snapshotTime: startTime + 60,
+		operations: []jobStatsOperation{
+			{name: "read_bytes", unit: "bytes", samples: reads, sum: readBytes},
+			{name: "open", unit: "reqs", samples: 2},
+		},
+	}
+}
+
+func TestJobCompletionLedger(t *testing.T) {
+	now := time.Unix(2000, 0)
+	l := newJobCompletionLedger(nil, 2)
+	l.now = func() time.Time { return now }
+
+	l.update("ost", "lustrefs-OST0000", []jobStatsRecord{testJobRecord("1", 100, 1024, 1), testJobRecord("2", 0, 0, 0)})
+	l.update("mdt", "lustrefs-MDT0000", []jobStatsRecord{testJobRecord("1", 100, 0, 0)})
+	if completed := l.completed(); len(completed) != 0 {
+		t.Fatalf("Expected no completed jobs, got %v", completed)
+	}
+
+	now = now.Add(time.Minute)
+	l.update("ost", "lustrefs-OST0000", []jobStatsRecord{testJobRecord("1", 100, 4096, 3)})
+	expected := []jobCompletion{{
+		JobID:      "2",
+		Component:  "ost",
+		Target:     "lustrefs-OST0000",
+		FirstSeen:  time.Unix(2000, 0),
+		LastSeen:   time.Unix(60, 0),
+		Operations: map[string]float64{"read_bytes": 0, "open": 2},
+	}}
+	if completed := l.completed(); !reflect.DeepEqual(completed, expected) {
+		t.Fatalf("Retrieved unexpected completed jobs. Expected: %v, Got: %v", expected, completed)
+	}
+
+	// Job 1 was cleaned up and started again, and the previous run carries its final counters
+	l.update("ost", "lustrefs-OST0000", []jobStatsRecord{testJobRecord("1", 500, 10, 1)})
+	l.update("ost", "lustrefs-OST0000", nil)
+	completed := l.completed()
+	if len(completed) != 2 {
+		t.Fatalf("Expected the two most recent completions to be kept, got %v", completed)
+	}
+	if completed[0].FirstSeen != time.Unix(100, 0) || completed[0].ReadBytes != 4096 || completed[0].Operations["read_bytes"] != 3 {
+		t.Fatalf("Retrieved unexpected counters for the first run of job 1: %v", completed[0])
+	}
+	if completed[1].FirstSeen != time.Unix(500, 0) || completed[1].ReadBytes != 10 {
+		t.Fatalf("Retrieved unexpected counters for the second run of job 1: %v", completed[1])
+	}
+
+	// The MDT wasn't read during the last scrape, so its jobs are complete
+	now = now.Add(time.Minute)
+	l.update("ost", "lustrefs-OST0000", nil)
+	l.expire(now)
+	completed = l.completed()
+	if completed[1].JobID != "1" || completed[1].Component != "mdt" || completed[1].Target != "lustrefs-MDT0000" {
+		t.Fatalf("Expected the jobs of the missing target to be complete, got %v", completed)
+	}
+	if _, ok := l.targets["mdt/lustrefs-MDT0000"]; ok || len(l.targets) != 1 {
+		t.Fatalf("Expected the missing target to be dropped, got %v", l.targets)
+	}
+}
+
+func TestJobLedgerWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jobledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	path := filepath.Join(dir, "ledger.json")
+	w, err := newJobLedgerWriter(path, 40, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		if err = w.write(map[string]string{"job_id": strings.Repeat("x", 20)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"ledger.json", "ledger.json.1", "ledger.json.2"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Count(string(data), "\n") != 1 {
+			t.Fatalf("Expected %s to hold a single record, got %q", name, data)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "ledger.json.3")); !os.IsNotExist(err) {
+		t.Fatalf("Expected only two backups to be kept, got %v", err)
+	}
+
+	// A failed rotation keeps appending to the current file, and is tried again on the next write
+	path = filepath.Join(dir, "failing.json")
+	if err = os.MkdirAll(filepath.Join(path+".1", "busy"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	w, err = newJobLedgerWriter(path, 40, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err = w.write(map[string]string{"job_id": strings.Repeat("x", 20)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.RemoveAll(path + ".1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.write(map[string]string{"job_id": strings.Repeat("x", 20)}); err != nil {
+		t.Fatal(err)
+	}
+	for name, lines := range map[string]int{"failing.json": 1, "failing.json.1": 2} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Count(string(data), "\n") != lines {
+			t.Fatalf("Expected %s to hold %d records, got %q", name, lines, data)
+		}
+	}
+}
+
+func TestCompletedJobsHandler(t *testing.T) {
+	defer func(l *jobCompletionLedger) { jobLedger = l }(jobLedger)
+
+	jobLedger = nil
+	recorder := httptest.NewRecorder()
+	CompletedJobsHandler(recorder, httptest.NewRequest("GET", "/jobs/completed", nil))
+	if recorder.Code != 404 {
+		t.Fatalf("Expected a 404 while job tracking is disabled, got %d", recorder.Code)
+	}
+
+	jobLedger = newJobCompletionLedger(nil, 10)
+	jobLedger.update("ost", "lustrefs-OST0000", []jobStatsRecord{testJobRecord("1", 100, 1024, 1), testJobRecord("2", 100, 0, 0)})
+	jobLedger.update("ost", "lustrefs-OST0000", nil)
+	recorder = httptest.NewRecorder()
+	CompletedJobsHandler(recorder, httptest.NewRequest("GET", "/jobs/completed", nil))
+	scanner := bufio.NewScanner(recorder.Body)
+	var jobIDs []string
+	for scanner.Scan() {
+		var c jobCompletion
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			t.Fatal(err)
+		}
+		jobIDs = append(jobIDs, c.JobID)
+	}
+	if len(jobIDs) != 2 {
+		t.Fatalf("Expected two completed jobs, got %v", jobIDs)
+	}
+}
@@ -0,0 +1,508 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/log"
+)
+
+// jobStatsField selects one of the numeric fields of a job_stats operation.
+type jobStatsField int
+
+const (
+	jobStatsSamples jobStatsField = iota
+	jobStatsMinimum
+	jobStatsMaximum
+	jobStatsSum
+	jobStatsSumSquare
+)
+
+// jobStatsOperation holds a single operation line of a job_stats record, such as
+// 'write_bytes: { samples: 262, unit: bytes, min: 1048576, max: 1048576, sum: 274726912, sumsq: 288072046051328 }'.
+type jobStatsOperation struct {
+	name    string
+	unit    string
+	samples float64
+	min     float64
+	max     float64
+	sum     float64
+	sumsq   float64
+}
+
+// jobStatsRecord holds everything reported for a single job ID within a job_stats file.
+type jobStatsRecord struct {
+	jobID        string
+	snapshotTime float64
+	startTime    float64
+	elapsedTime  float64
+	operations   []jobStatsOperation
+}
+
+func (o jobStatsOperation) value(field jobStatsField) float64 {
+	switch field {
+	case jobStatsMinimum:
+		return o.min
+	case jobStatsMaximum:
+		return o.max
+	case jobStatsSum:
+		return o.sum
+	case jobStatsSumSquare:
+		return o.sumsq
+	default:
+		return o.samples
+	}
+}
+
+func (j jobStatsRecord) operation(name string) (jobStatsOperation, bool) {
+	for _, op := range j.operations {
+		if op.name == name {
+			return op, true
+		}
+	}
+	return jobStatsOperation{}, false
+}
+
+// parseJobStats reads a job_stats file line by line and calls handler once per job. Only the
+// record currently being read is held in memory, so the cost of a scrape grows with the number
+// of jobs but the memory footprint does not.
+func parseJobStats(r io.Reader, handler func(jobStatsRecord) error) error {
+	var job *jobStatsRecord
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "job_stats:" {
+			continue
+		}
+		if strings.HasPrefix(line, "- ") {
+			if job != nil {
+				if err := handler(*job); err != nil {
+					return err
+				}
+			}
+			job = &jobStatsRecord{}
+			line = strings.TrimSpace(line[2:])
+		}
+		if job == nil {
+			// Anything before the first record isn't tied to a job
+			continue
+		}
+		key, value, err := splitJobStatsLine(line)
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "job_id":
+			job.jobID = unquoteJobStatsValue(value)
+		case "snapshot_time":
+			job.snapshotTime, err = parseJobStatsTime(value)
+		case "start_time":
+			job.startTime, err = parseJobStatsTime(value)
+		case "elapsed_time":
+			job.elapsedTime, err = parseJobStatsTime(value)
+		default:
+			if strings.HasPrefix(value, "{") {
+				var op jobStatsOperation
+				op, err = parseJobStatsOperation(key, value)
+				job.operations = append(job.operations, op)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("job_stats: job %q: %s", job.jobID, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if job != nil {
+		return handler(*job)
+	}
+	return nil
+}
+
+func splitJobStatsLine(line string) (key string, value string, err error) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("job_stats: unable to parse line %q", line)
+	}
+	return strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:]), nil
+}
+
+func unquoteJobStatsValue(value string) string {
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+// parseJobStatsTime accepts both the plain seconds used by older releases and the
+// '<seconds>.<nanoseconds> secs.nsecs' format used by newer ones.
+func parseJobStatsTime(value string) (float64, error) {
+	if i := strings.IndexByte(value, ' '); i >= 0 {
+		value = value[:i]
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseJobStatsOperation parses the flow mapping of a single operation. Nested mappings (such as
+// the 'hist' field of newer releases) are skipped.
+func parseJobStatsOperation(name string, value string) (op jobStatsOperation, err error) {
+	op.name = name
+	body := strings.TrimSpace(value)
+	body = strings.TrimPrefix(body, "{")
+	body = strings.TrimSuffix(body, "}")
+	depth := 0
+	start := 0
+	for i := 0; i <= len(body); i++ {
+		if i < len(body) {
+			switch body[i] {
+			case '{':
+				depth++
+				continue
+			case '}':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		if err = op.setField(body[start:i]); err != nil {
+			return op, err
+		}
+		start = i + 1
+	}
+	return op, nil
+}
+
+func (o *jobStatsOperation) setField(field string) (err error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return nil
+	}
+	i := strings.Index(field, ":")
+	if i < 0 {
+		return fmt.Errorf("unable to parse field %q of operation %q", field, o.name)
+	}
+	key := strings.TrimSpace(field[:i])
+	value := strings.TrimSpace(field[i+1:])
+	switch key {
+	case "unit":
+		o.unit = value
+	case "samples":
+		o.samples, err = strconv.ParseFloat(value, 64)
+	case "min":
+		o.min, err = strconv.ParseFloat(value, 64)
+	case "max":
+		o.max, err = strconv.ParseFloat(value, 64)
+	case "sum":
+		o.sum, err = strconv.ParseFloat(value, 64)
+	case "sumsq":
+		o.sumsq, err = strconv.ParseFloat(value, 64)
+	}
+	return err
+}
+
+const (
+	// jobStatsOtherJobID is the job ID of the record that aggregates all jobs dropped by a jobStatsSelector
+	jobStatsOtherJobID string = "other"
+
+	jobStatsSortByAuto  string = "auto"
+	jobStatsSortByBytes string = "bytes"
+	jobStatsSortByOps   string = "ops"
+
+	// jobStatsSuppressedPrefix is the prefix of the metrics holding the values of the jobs dropped by a
+	// jobStatsSelector. They are gauges, as their values drop whenever jobs move in or out of the top N.
+	jobStatsSuppressedPrefix string = "exporter_job_stats_suppressed_"
+)
+
+var (
+	// JobStatsTopN limits the number of jobs exported per target to the N most active ones. 0 exports all jobs.
+	JobStatsTopN int
+	// JobStatsSortBy selects how job activity is measured: "bytes" read and written, "ops" performed, or "auto"
+	// for the measure of each component.
+	JobStatsSortBy = jobStatsSortByAuto
+	// JobStatsMinActivity is the activity (in JobStatsSortBy units) a job needs to be exported on its own.
+	JobStatsMinActivity float64
+	// JobStatsTTL is how long after its last update a job is still exported on its own. 0 exports every job.
+	JobStatsTTL time.Duration
+
+	// componentSortBy measures how active a job or a client is on each component. MDTs have no byte counters.
+	componentSortBy = map[string]string{
+		"ost": jobStatsSortByBytes,
+		"mdt": jobStatsSortByOps,
+	}
+)
+
+// jobStatsSortByFor returns how job activity is measured on the component. Bytes fall back to operations on the
+// components without byte counters, where every job would otherwise rank at 0.
+func jobStatsSortByFor(sortBy string, nodeType string) string {
+	if sortBy == jobStatsSortByAuto || (sortBy == jobStatsSortByBytes && componentSortBy[nodeType] == jobStatsSortByOps) {
+		return componentSortBy[nodeType]
+	}
+	return sortBy
+}
+
+// activity returns the total number of bytes read and written by the job, or the total number of operations.
+func (j jobStatsRecord) activity(sortBy string) (activity float64) {
+	for _, op := range j.operations {
+		if sortBy == jobStatsSortByOps {
+			activity += op.samples
+		} else if op.unit == "bytes" {
+			activity += op.sum
+		}
+	}
+	return activity
+}
+
+// merge adds the operations of o to the record, creating any that don't exist yet.
+func (j *jobStatsRecord) merge(o jobStatsRecord) {
+	for _, op := range o.operations {
+		i := 0
+		for i < len(j.operations) && j.operations[i].name != op.name {
+			i++
+		}
+		if i == len(j.operations) {
+			j.operations = append(j.operations, jobStatsOperation{name: op.name, unit: op.unit})
+		}
+		merged := &j.operations[i]
+		if op.samples > 0 {
+			if merged.samples == 0 || op.min < merged.min {
+				merged.min = op.min
+			}
+			if op.max > merged.max {
+				merged.max = op.max
+			}
+		}
+		merged.samples += op.samples
+		merged.sum += op.sum
+		merged.sumsq += op.sumsq
+	}
+	if o.snapshotTime > j.snapshotTime {
+		j.snapshotTime = o.snapshotTime
+	}
+}
+
+type rankedJob struct {
+	activity float64
+	job      jobStatsRecord
+}
+
+// rankedJobs is a min-heap of jobs ordered by activity
+type rankedJobs []rankedJob
+
+func (r rankedJobs) Len() int            { return len(r) }
+func (r rankedJobs) Less(i, j int) bool  { return r[i].activity < r[j].activity }
+func (r rankedJobs) Swap(i, j int)       { r[i], r[j] = r[j], r[i] }
+func (r *rankedJobs) Push(x interface{}) { *r = append(*r, x.(rankedJob)) }
+func (r *rankedJobs) Pop() interface{} {
+	old := *r
+	item := old[len(old)-1]
+	*r = old[:len(old)-1]
+	return item
+}
+
+// jobStatsSelector limits the jobs of a single job_stats file to the topN most active ones which reach
+// minActivity and were updated since minSnapshotTime, folding every other job into a single 'other' record so that
+// totals are preserved. Without a topN limit, jobs are never buffered and the selection is done while streaming.
+type jobStatsSelector struct {
+	topN            int
+	sortBy          string
+	minActivity     float64
+	minSnapshotTime float64
+	ranked          rankedJobs
+	other           jobStatsRecord
+	suppressed      int
+}
+
+func newJobStatsSelector(topN int, sortBy string, minActivity float64) *jobStatsSelector {
+	return &jobStatsSelector{
+		topN:        topN,
+		sortBy:      sortBy,
+		minActivity: minActivity,
+		other:       jobStatsRecord{jobID: jobStatsOtherJobID},
+	}
+}
+
+// add returns true if the job can be exported right away. Otherwise, the job is either held until
+// the file has been read completely, or it has been folded into the 'other' record.
+func (s *jobStatsSelector) add(job jobStatsRecord) bool {
+	if s.topN <= 0 && s.minActivity <= 0 && s.minSnapshotTime <= 0 {
+		return true
+	}
+	activity := job.activity(s.sortBy)
+	if activity < s.minActivity || job.snapshotTime < s.minSnapshotTime {
+		s.suppress(job)
+		return false
+	}
+	if s.topN <= 0 {
+		return true
+	}
+	heap.Push(&s.ranked, rankedJob{activity: activity, job: job})
+	if len(s.ranked) > s.topN {
+		s.suppress(heap.Pop(&s.ranked).(rankedJob).job)
+	}
+	return false
+}
+
+func (s *jobStatsSelector) suppress(job jobStatsRecord) {
+	s.other.merge(job)
+	s.suppressed++
+}
+
+// kept returns the held jobs, most active first.
+func (s *jobStatsSelector) kept() []jobStatsRecord {
+	ranked := make(rankedJobs, len(s.ranked))
+	copy(ranked, s.ranked)
+	sort.Sort(sort.Reverse(ranked))
+	jobs := make([]jobStatsRecord, 0, len(ranked)+1)
+	for _, r := range ranked {
+		jobs = append(jobs, r.job)
+	}
+	return jobs
+}
+
+// selected returns the held jobs, most active first, followed by the 'other' record if any job was suppressed.
+func (s *jobStatsSelector) selected() []jobStatsRecord {
+	jobs := s.kept()
+	if s.suppressed > 0 {
+		jobs = append(jobs, s.other)
+	}
+	return jobs
+}
+
+const (
+	jobStatsAggregateUser    string = "user"
+	jobStatsAggregateGroup   string = "group"
+	jobStatsAggregateProject string = "project"
+)
+
+var (
+	// JobStatsAggregations lists what job_stats are rolled up by per target: "user", "group" and/or "project".
+	JobStatsAggregations []string
+	// JobStatsPerJob specifies whether per-job series are exported. Aggregations don't depend on it.
+	JobStatsPerJob = true
+
+	// jobStatsAggregationKeys maps each aggregation to the job labels it is keyed by, in order of preference, to
+	// the label it is exported with, and to the jobid template verb the key comes from. Projects are the accounts
+	// of the job metadata when available, and the '%j' portion of the job IDs otherwise, for sites whose jobid_var
+	// names a project.
+	jobStatsAggregationKeys = map[string]struct {
+		jobLabels   []string
+		exportLabel string
+		verb        string
+	}{
+		jobStatsAggregateUser:    {[]string{"uid"}, "uid", "%u"},
+		jobStatsAggregateGroup:   {[]string{"gid"}, "gid", "%g"},
+		jobStatsAggregateProject: {[]string{"account", "jobid"}, "project", "%j"},
+	}
+)
+
+// jobStatsAggregator sums up the jobs of a single job_stats file that share the same user, group or project.
+type jobStatsAggregator struct {
+	kind    string
+	label   string
+	index   int
+	records map[string]*jobStatsRecord
+}
+
+// newJobStatsAggregators returns an aggregator for each of kinds that the given job labels carry a key for.
+// Rolling up by user, for example, requires the '%u' verb in the jobid template.
+func newJobStatsAggregators(labels []string, kinds []string) (aggregators []*jobStatsAggregator) {
+	for _, kind := range kinds {
+		key, ok := jobStatsAggregationKeys[kind]
+		if !ok {
+			continue
+		}
+		index := findJobLabel(labels, key.jobLabels)
+		if index < 0 {
+			continue
+		}
+		aggregators = append(aggregators, &jobStatsAggregator{
+			kind:    kind,
+			label:   key.exportLabel,
+			index:   index,
+			records: make(map[string]*jobStatsRecord),
+		})
+	}
+	return aggregators
+}
+
+// findJobLabel returns the index in labels of the first of wanted that labels holds, or -1 if none.
+func findJobLabel(labels []string, wanted []string) int {
+	for _, want := range wanted {
+		for i, label := range labels {
+			if label == want {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// warnMissingJobStatsAggregations logs a warning for each of kinds that the given job labels carry no key for, as
+// no series are exported for them.
+func warnMissingJobStatsAggregations(labels []string, kinds []string) {
+	aggregated := make(map[string]bool)
+	for _, aggregator := range newJobStatsAggregators(labels, kinds) {
+		aggregated[aggregator.kind] = true
+	}
+	for _, kind := range kinds {
+		if !aggregated[kind] {
+			log.Warnf("Unable to aggregate job_stats by %s: the jobid template has no '%s' verb", kind, jobStatsAggregationKeys[kind].verb)
+		}
+	}
+}
+
+// add merges the job into the record of its key, given the job's label values.
+// Jobs whose ID didn't carry the key are skipped.
+func (a *jobStatsAggregator) add(labelValues []string, job jobStatsRecord) {
+	key := labelValues[a.index]
+	if key == "" {
+		return
+	}
+	record, ok := a.records[key]
+	if !ok {
+		record = &jobStatsRecord{jobID: key}
+		a.records[key] = record
+	}
+	record.merge(job)
+}
+
+// aggregated returns the rolled up records, sorted by key.
+func (a *jobStatsAggregator) aggregated() []jobStatsRecord {
+	keys := make([]string, 0, len(a.records))
+	for key := range a.records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	records := make([]jobStatsRecord, 0, len(keys))
+	for _, key := range keys {
+		records = append(records, *a.records[key])
+	}
+	return records
+}
@@ -0,0 +1,315 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func parseTestJob(jobBlock string) (job jobStatsRecord, err error) {
+	err = parseJobStats(strings.NewReader(jobBlock), func(j jobStatsRecord) error {
+		job = j
+		return nil
+	})
+	return job, err
+}
+
+func TestParseJobStats(t *testing.T) {
+	testJobStats := `job_stats:
+- job_id:          dd.0:node-01
+  snapshot_time:   1493326943
+  read_bytes:      { samples:           0, unit: bytes, min:       0, max:       0, sum:               0 }
+  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
+  punch:           { samples:           3, unit:  reqs }
+- job_id:          "cp-1.1001"
+  snapshot_time:   1681232001.627428373 secs.nsecs
+  start_time:      1681231990.000000000 secs.nsecs
+  elapsed_time:    11.627428373 secs.nsecs
+  read_bytes:      { samples:           2, unit: bytes, min:    4096, max:    8192, sum:           12288, sumsq:       83886080, hist: { 4K: 1, 8K: 1 } }
+  open:            { samples:           5, unit: usecs, min:       1, max:      20, sum:              40, sumsq:            500 }
+`
+	var jobs []jobStatsRecord
+	err := parseJobStats(strings.NewReader(testJobStats), func(job jobStatsRecord) error {
+		jobs = append(jobs, job)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(jobs); l != 2 {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 2, l)
+	}
+
+	if jobs[0].jobID != "dd.0:node-01" {
+		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", "dd.0:node-01", jobs[0].jobID)
+	}
+	if jobs[0].snapshotTime != 1493326943 {
+		t.Fatalf("Retrieved an unexpected snapshot time. Expected: %f, Got: %f", float64(1493326943), jobs[0].snapshotTime)
+	}
+	if l := len(jobs[0].operations); l != 3 {
+		t.Fatalf("Retrieved an unexpected number of operations. Expected: %d, Got: %d", 3, l)
+	}
+	op, ok := jobs[0].operation("write_bytes")
+	if !ok {
+		t.Fatal("Operation write_bytes was not found")
+	}
+	if op.unit != "bytes" || op.samples != 262 || op.min != 1048576 || op.max != 1048576 || op.sum != 274726912 {
+		t.Fatalf("Retrieved an unexpected write_bytes operation: %+v", op)
+	}
+	op, ok = jobs[0].operation("punch")
+	if !ok {
+		t.Fatal("Operation punch was not found")
+	}
+	if op.unit != "reqs" || op.samples != 3 {
+		t.Fatalf("Retrieved an unexpected punch operation: %+v", op)
+	}
+
+	if jobs[1].jobID != "cp-1.1001" {
+		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", "cp-1.1001", jobs[1].jobID)
+	}
+	if jobs[1].snapshotTime != 1681232001.627428373 {
+		t.Fatalf("Retrieved an unexpected snapshot time. Expected: %f, Got: %f", 1681232001.627428373, jobs[1].snapshotTime)
+	}
+	if jobs[1].startTime != 1681231990 {
+		t.Fatalf("Retrieved an unexpected start time. Expected: %f, Got: %f", float64(1681231990), jobs[1].startTime)
+	}
+	if jobs[1].elapsedTime != 11.627428373 {
+		t.Fatalf("Retrieved an unexpected elapsed time. Expected: %f, Got: %f", 11.627428373, jobs[1].elapsedTime)
+	}
+	op, ok = jobs[1].operation("read_bytes")
+	if !ok {
+		t.Fatal("Operation read_bytes was not found")
+	}
+	if op.samples != 2 || op.sum != 12288 || op.sumsq != 83886080 {
+		t.Fatalf("Retrieved an unexpected read_bytes operation: %+v", op)
+	}
+	op, ok = jobs[1].operation("open")
+	if !ok {
+		t.Fatal("Operation open was not found")
+	}
+	if op.unit != "usecs" || op.samples != 5 || op.value(jobStatsSum) != 40 || op.value(jobStatsSumSquare) != 500 {
+		t.Fatalf("Retrieved an unexpected open operation: %+v", op)
+	}
+
+	_, err = parseTestJob("- job_id: 1\n  write_bytes: { samples: many, unit: bytes }")
+	if err == nil {
+		t.Fatal("Expected an error for a non-numeric sample count")
+	}
+
+	jobs = nil
+	err = parseJobStats(strings.NewReader("job_stats:\n"), func(job jobStatsRecord) error {
+		jobs = append(jobs, job)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(jobs); l != 0 {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 0, l)
+	}
+}
+
+func TestJobStatsSelector(t *testing.T) {
+	testJobs := []jobStatsRecord{
+		{jobID: "1", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 1, min: 10, max: 10, sum: 10}, {name: "punch", unit: "reqs", samples: 7}}},
+		{jobID: "2", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 2, min: 100, max: 200, sum: 300}}},
+		{jobID: "3", operations: []jobStatsOperation{{name: "read_bytes", unit: "bytes", samples: 1, min: 50, max: 50, sum: 50}}},
+		{jobID: "4", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 1, min: 5, max: 5, sum: 5}, {name: "punch", unit: "reqs", samples: 1}}},
+	}
+
+	// Without any limits every job is exported right away
+	selector := newJobStatsSelector(0, jobStatsSortByBytes, 0)
+	for _, job := range testJobs {
+		if !selector.add(job) {
+			t.Fatalf("Job %s was unexpectedly held back", job.jobID)
+		}
+	}
+	if l := len(selector.selected()); l != 0 {
+		t.Fatalf("Retrieved an unexpected number of held jobs. Expected: %d, Got: %d", 0, l)
+	}
+
+	selector = newJobStatsSelector(2, jobStatsSortByBytes, 0)
+	for _, job := range testJobs {
+		if selector.add(job) {
+			t.Fatalf("Job %s was unexpectedly exported before ranking", job.jobID)
+		}
+	}
+	jobs := selector.selected()
+	if l := len(jobs); l != 3 {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 3, l)
+	}
+	if jobs[0].jobID != "2" || jobs[1].jobID != "3" || jobs[2].jobID != jobStatsOtherJobID {
+		t.Fatalf("Retrieved jobs in an unexpected order: %s, %s, %s", jobs[0].jobID, jobs[1].jobID, jobs[2].jobID)
+	}
+	if selector.suppressed != 2 {
+		t.Fatalf("Retrieved an unexpected number of suppressed jobs. Expected: %d, Got: %d", 2, selector.suppressed)
+	}
+	op, _ := jobs[2].operation("write_bytes")
+	if op.samples != 2 || op.min != 5 || op.max != 10 || op.sum != 15 {
+		t.Fatalf("Retrieved an unexpected write_bytes operation for the 'other' job: %+v", op)
+	}
+	op, _ = jobs[2].operation("punch")
+	if op.samples != 8 {
+		t.Fatalf("Retrieved an unexpected punch operation for the 'other' job: %+v", op)
+	}
+
+	selector = newJobStatsSelector(0, jobStatsSortByOps, 2)
+	var exported []string
+	for _, job := range testJobs {
+		if selector.add(job) {
+			exported = append(exported, job.jobID)
+		}
+	}
+	if len(exported) != 3 || exported[0] != "1" || exported[1] != "2" || exported[2] != "4" {
+		t.Fatalf("Retrieved an unexpected list of exported jobs: %v", exported)
+	}
+	jobs = selector.selected()
+	if len(jobs) != 1 || jobs[0].jobID != jobStatsOtherJobID {
+		t.Fatalf("Retrieved an unexpected list of held jobs: %+v", jobs)
+	}
+	if a := jobs[0].activity(jobStatsSortByOps); a != 1 {
+		t.Fatalf("Retrieved an unexpected activity for the 'other' job. Expected: %f, Got: %f", float64(1), a)
+	}
+
+	// MDT jobs have no byte counters, so they are ranked by operations even when sorting by bytes
+	mdtJobs := []jobStatsRecord{
+		{jobID: "1", operations: []jobStatsOperation{{name: "open", unit: "usecs", samples: 3}}},
+		{jobID: "2", operations: []jobStatsOperation{{name: "open", unit: "usecs", samples: 9}, {name: "close", unit: "usecs", samples: 9}}},
+		{jobID: "3", operations: []jobStatsOperation{{name: "mkdir", unit: "usecs", samples: 5}}},
+	}
+	for _, sortBy := range []string{jobStatsSortByAuto, jobStatsSortByBytes} {
+		selector = newJobStatsSelector(2, jobStatsSortByFor(sortBy, "mdt"), 4)
+		for _, job := range mdtJobs {
+			selector.add(job)
+		}
+		jobs = selector.kept()
+		if len(jobs) != 2 || jobs[0].jobID != "2" || jobs[1].jobID != "3" {
+			t.Fatalf("Retrieved an unexpected list of MDT jobs sorting by %s: %+v", sortBy, jobs)
+		}
+		if selector.suppressed != 1 {
+			t.Fatalf("Retrieved an unexpected number of suppressed MDT jobs. Expected: %d, Got: %d", 1, selector.suppressed)
+		}
+	}
+	if sortBy := jobStatsSortByFor(jobStatsSortByAuto, "ost"); sortBy != jobStatsSortByBytes {
+		t.Fatalf("Retrieved an unexpected OST sort order. Expected: %s, Got: %s", jobStatsSortByBytes, sortBy)
+	}
+
+	// Jobs that weren't updated since the minimum snapshot time are suppressed
+	selector = newJobStatsSelector(0, jobStatsSortByBytes, 0)
+	selector.minSnapshotTime = 1000
+	exported = nil
+	for _, job := range []jobStatsRecord{{jobID: "1", snapshotTime: 999}, {jobID: "2", snapshotTime: 1000}} {
+		if selector.add(job) {
+			exported = append(exported, job.jobID)
+		}
+	}
+	if len(exported) != 1 || exported[0] != "2" || selector.suppressed != 1 {
+		t.Fatalf("Retrieved an unexpected list of exported jobs: %v", exported)
+	}
+}
+
+func TestJobStatsAggregator(t *testing.T) {
+	jobIDs := newJobIDDecoder("%e.%u")
+	aggregators := newJobStatsAggregators(jobIDs.labels, []string{jobStatsAggregateUser, jobStatsAggregateGroup})
+	// There is no '%g' verb in the template, so jobs can't be rolled up by group
+	if l := len(aggregators); l != 1 {
+		t.Fatalf("Retrieved an unexpected number of aggregators. Expected: %d, Got: %d", 1, l)
+	}
+	if aggregators[0].kind != jobStatsAggregateUser || aggregators[0].label != "uid" {
+		t.Fatalf("Retrieved an unexpected aggregator: %+v", aggregators[0])
+	}
+
+	testJobs := []jobStatsRecord{
+		{jobID: "dd.1000", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 1, min: 10, max: 10, sum: 10}}},
+		{jobID: "cp.1000", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 2, min: 100, max: 200, sum: 300}}},
+		{jobID: "cp.0", operations: []jobStatsOperation{{name: "read_bytes", unit: "bytes", samples: 1, min: 50, max: 50, sum: 50}}},
+		{jobID: "1234", operations: []jobStatsOperation{{name: "write_bytes", unit: "bytes", samples: 1, min: 5, max: 5, sum: 5}}},
+	}
+	for _, job := range testJobs {
+		values := jobIDs.decode(job.jobID)
+		for _, aggregator := range aggregators {
+			aggregator.add(values, job)
+		}
+	}
+
+	users := aggregators[0].aggregated()
+	if l := len(users); l != 2 {
+		t.Fatalf("Retrieved an unexpected number of users. Expected: %d, Got: %d", 2, l)
+	}
+	if users[0].jobID != "0" || users[1].jobID != "1000" {
+		t.Fatalf("Retrieved unexpected users: %s, %s", users[0].jobID, users[1].jobID)
+	}
+	op, _ := users[1].operation("write_bytes")
+	if op.samples != 3 || op.min != 10 || op.max != 200 || op.sum != 310 {
+		t.Fatalf("Retrieved an unexpected write_bytes operation for uid 1000: %+v", op)
+	}
+
+	// Projects are the accounts of the job metadata when enabled, and the '%j' portion of the job IDs otherwise
+	labels := newJobIDDecoder("%j.%u").labels
+	projects := newJobStatsAggregators(labels, []string{jobStatsAggregateProject})
+	if len(projects) != 1 || projects[0].label != "project" || labels[projects[0].index] != "jobid" {
+		t.Fatalf("Retrieved unexpected project aggregators without job metadata: %+v", projects)
+	}
+	labels = append(labels, jobInfoLabels...)
+	projects = newJobStatsAggregators(labels, []string{jobStatsAggregateProject})
+	if len(projects) != 1 || labels[projects[0].index] != "account" {
+		t.Fatalf("Retrieved unexpected project aggregators with job metadata: %+v", projects)
+	}
+	projects[0].add([]string{"1234", "1000", "alice", "physics", "batch", "sim"}, testJobs[0])
+	projects[0].add([]string{"1235", "1001", "bob", "physics", "batch", "sim"}, testJobs[1])
+	projects[0].add([]string{"1236", "1001", "", "", "", ""}, testJobs[2])
+	if records := projects[0].aggregated(); len(records) != 1 || records[0].jobID != "physics" {
+		t.Fatalf("Retrieved unexpected projects: %+v", records)
+	}
+}
+
+func generateJobStats(numJobs int) []byte {
+	var b bytes.Buffer
+	b.WriteString("job_stats:\n")
+	for i := 0; i < numJobs; i++ {
+		fmt.Fprintf(&b, "- job_id:          slurm-%d.%d:node%04d\n", i, i%16, i%512)
+		b.WriteString("  snapshot_time:   1681232001.627428373 secs.nsecs\n")
+		b.WriteString("  start_time:      1681231990.000000000 secs.nsecs\n")
+		b.WriteString("  elapsed_time:    11.627428373 secs.nsecs\n")
+		fmt.Fprintf(&b, "  read_bytes:      { samples: %11d, unit: bytes, min:    4096, max: 4194304, sum: %15d, sumsq: %20d }\n", i, i*4096, i*4096*4096)
+		fmt.Fprintf(&b, "  write_bytes:     { samples: %11d, unit: bytes, min:    4096, max: 4194304, sum: %15d, sumsq: %20d }\n", i, i*4096, i*4096*4096)
+		for _, op := range []string{"read", "write", "getattr", "setattr", "punch", "sync", "destroy", "create", "statfs", "get_info", "set_info", "quotactl"} {
+			fmt.Fprintf(&b, "  %-16s { samples: %11d, unit: usecs, min:       1, max:    1000, sum: %15d, sumsq: %20d }\n", op+":", i, i*10, i*100)
+		}
+	}
+	return b.Bytes()
+}
+
+func BenchmarkParseJobStats(b *testing.B) {
+	numJobs := 10000
+	jobStatsFile := generateJobStats(numJobs)
+	b.SetBytes(int64(len(jobStatsFile)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parsed := 0
+		err := parseJobStats(bytes.NewReader(jobStatsFile), func(job jobStatsRecord) error {
+			parsed++
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if parsed != numJobs {
+			b.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", numJobs, parsed)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	lfsckLayout    string = "lfsck_layout"
+	lfsckNamespace string = "lfsck_namespace"
+
+	// Help text dedicated to the 'lfsck_layout' and 'lfsck_namespace' files
+	lfsckStatusHelp          string = "Current status of the LFSCK scan: 1 for the reported status, 0 for the others."
+	lfsckSuccessHelp         string = "Total number of LFSCK scans that completed successfully."
+	lfsckRepairedHelp        string = "Total number of inconsistencies repaired by LFSCK, by type of inconsistency."
+	lfsckCheckedHelp         string = "Number of objects checked by the last or current LFSCK scan, by phase."
+	lfsckFailedHelp          string = "Number of objects LFSCK failed to check or repair during the last or current scan, by phase."
+	lfsckRunTimeHelp         string = "Time in seconds the last or current LFSCK scan has run, by phase."
+	lfsckSinceCompletedHelp  string = "Time in seconds since the last LFSCK scan completed."
+	lfsckSinceStartHelp      string = "Time in seconds since the latest LFSCK scan started."
+	lfsckSinceCheckpointHelp string = "Time in seconds since the current LFSCK scan last saved a checkpoint."
+	lfsckNotAvailable        string = "N/A"
+	lfsckRepairedPrefix      string = "repaired_"
+	lfsckRepairedSuffix      string = "_repaired"
+	lfsckRunTimePrefix       string = "run_time_"
+	lfsckCheckedPrefix       string = "checked_"
+	lfsckFailedPrefix        string = "failed_"
+	lfsckUnknownStatus       string = "unknown"
+)
+
+// lfsckStatuses are always exported by lustre_lfsck_status, so that each of them can be alerted on
+var lfsckStatuses = []string{"init", "scanning-phase1", "scanning-phase2", "completed", "failed", "stopped", "paused", "crashed", "partial", "co-failed", "co-stopped", "co-paused"}
+
+// lfsckFieldValue returns the numeric value of a field of an LFSCK file, such as '12' or '12 seconds'. Fields
+// reported as 'N/A' have no value.
+func lfsckFieldValue(fields map[string]string, key string) (float64, bool, error) {
+	value, exists := fields[key]
+	if !exists || value == "" || strings.HasPrefix(value, lfsckNotAvailable) {
+		return 0, false, nil
+	}
+	result, err := strconv.ParseFloat(strings.Fields(value)[0], 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return result, true, nil
+}
+
+// getLFSCKPrefixedMetrics returns a value for every field whose name starts with the prefix or ends with the suffix,
+// labeled with the rest of its name.
+func getLFSCKPrefixedMetrics(fields map[string]string, prefix string, suffix string, label string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var name string
+		switch {
+		case prefix != "" && strings.HasPrefix(key, prefix):
+			name = strings.TrimPrefix(key, prefix)
+		case suffix != "" && strings.HasSuffix(key, suffix):
+			name = strings.TrimSuffix(key, suffix)
+		default:
+			continue
+		}
+		value, exists, err := lfsckFieldValue(fields, key)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: label, extraLabelValue: name})
+		}
+	}
+	return metricList, nil
+}
+
+func getLFSCKMetrics(fields map[string]string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	switch helpText {
+	case lfsckStatusHelp:
+		status := fields["status"]
+		if status == "" {
+			status = lfsckUnknownStatus
+		}
+		known := false
+		for _, s := range lfsckStatuses {
+			value := 0.0
+			if s == status {
+				value = 1
+				known = true
+			}
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "status", extraLabelValue: s})
+		}
+		if !known {
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: 1, extraLabel: "status", extraLabelValue: status})
+		}
+		return metricList, nil
+	case lfsckRepairedHelp:
+		// The layout scan names its counters 'repaired_{type}', and the namespace scan '{type}_repaired'
+		return getLFSCKPrefixedMetrics(fields, lfsckRepairedPrefix, lfsckRepairedSuffix, "type", promName, helpText)
+	case lfsckCheckedHelp:
+		return getLFSCKPrefixedMetrics(fields, lfsckCheckedPrefix, "", "phase", promName, helpText)
+	case lfsckFailedHelp:
+		return getLFSCKPrefixedMetrics(fields, lfsckFailedPrefix, "", "phase", promName, helpText)
+	case lfsckRunTimeHelp:
+		return getLFSCKPrefixedMetrics(fields, lfsckRunTimePrefix, "", "phase", promName, helpText)
+	}
+
+	// fieldMap matches the given helpText value with the field holding its value
+	fieldMap := map[string]string{
+		lfsckSuccessHelp:         "success_count",
+		lfsckSinceCompletedHelp:  "time_since_last_completed",
+		lfsckSinceStartHelp:      "time_since_latest_start",
+		lfsckSinceCheckpointHelp: "time_since_last_checkpoint",
+	}
+	key, exists := fieldMap[helpText]
+	if !exists {
+		return nil, nil
+	}
+	value, exists, err := lfsckFieldValue(fields, key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: value}}, nil
+}
+
+// parseLFSCKFile emits the values of a template from the status file of an LFSCK scan, labeled with the kind of scan.
+func (s *lustreProcfsSource) parseLFSCKFile(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	filename, nodeName, err := parseFileElements(path, 0)
+	if err != nil {
+		return err
+	}
+	fields, err := readKeyValueFile(path)
+	if err != nil {
+		return err
+	}
+	metricList, err := getLFSCKMetrics(fields, metric.promName, metric.helpText)
+	if err != nil {
+		return err
+	}
+	labels := []string{"component", "target", "kind"}
+	labelValues := []string{nodeType, nodeName, strings.TrimPrefix(filename, "lfsck_")}
+	for _, item := range metricList {
+		if item.extraLabelValue == "" {
+			handler(metric.metricFunc, labels, labelValues, item.title, item.help, item.value)
+		} else {
+			handler(metric.metricFunc, append(labels, item.extraLabel), append(labelValues, item.extraLabelValue), item.title, item.help, item.value)
+		}
+	}
+	return nil
+}
+
+// lfsckMetricTemplates are the templates of the given LFSCK status files, shared by the OSTs and the MDTs
+func (s *lustreProcfsSource) lfsckMetricTemplates(filenames ...string) (metricList []lustreHelpStruct) {
+	for _, filename := range filenames {
+		metricList = append(metricList, []lustreHelpStruct{
+			{filename, "lfsck_status", lfsckStatusHelp, s.gaugeMetric, true, core},
+			{filename, "lfsck_success_total", lfsckSuccessHelp, s.counterMetric, false, core},
+			{filename, "lfsck_repaired_total", lfsckRepairedHelp, s.counterMetric, true, core},
+			{filename, "lfsck_run_time_seconds", lfsckRunTimeHelp, s.gaugeMetric, true, core},
+			{filename, "lfsck_time_since_last_completed_seconds", lfsckSinceCompletedHelp, s.gaugeMetric, false, core},
+			{filename, "lfsck_time_since_latest_start_seconds", lfsckSinceStartHelp, s.gaugeMetric, false, extended},
+			{filename, "lfsck_time_since_last_checkpoint_seconds", lfsckSinceCheckpointHelp, s.gaugeMetric, false, extended},
+			{filename, "lfsck_checked_objects", lfsckCheckedHelp, s.gaugeMetric, true, extended},
+			{filename, "lfsck_failed_objects", lfsckFailedHelp, s.gaugeMetric, true, extended},
+		}...)
+	}
+	return metricList
+}
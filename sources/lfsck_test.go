@@ -0,0 +1,89 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"testing"
+)
+
+func TestGetLFSCKMetrics(t *testing.T) {
+	fields := map[string]string{
+		"status":                    "scanning-phase2",
+		"time_since_last_completed": "N/A",
+		"time_since_latest_start":   "125 seconds",
+		"repaired_dangling":         "3",
+		"dirent_repaired":           "2",
+		"unknown_inconsistency":     "1",
+		"success_count":             "4",
+		"run_time_phase1":           "120 seconds",
+		"run_time_phase2":           "5 seconds",
+	}
+
+	status, err := getLFSCKMetrics(fields, "test", lfsckStatusHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status) != len(lfsckStatuses) {
+		t.Fatalf("Unexpected status metrics: %+v", status)
+	}
+	for _, item := range status {
+		if (item.value == 1) != (item.extraLabelValue == "scanning-phase2") {
+			t.Fatalf("Unexpected status metric: %+v", item)
+		}
+	}
+	fields["status"] = "rescanning"
+	status, err = getLFSCKMetrics(fields, "test", lfsckStatusHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := status[len(status)-1]; len(status) != len(lfsckStatuses)+1 || last.extraLabelValue != "rescanning" || last.value != 1 {
+		t.Fatalf("Unexpected status metrics for an unknown status: %+v", status)
+	}
+
+	repaired, err := getLFSCKMetrics(fields, "test", lfsckRepairedHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repaired) != 2 || repaired[0].extraLabelValue != "dirent" || repaired[0].value != 2 || repaired[1].extraLabelValue != "dangling" || repaired[1].value != 3 {
+		t.Fatalf("Unexpected repaired metrics: %+v", repaired)
+	}
+
+	runTime, err := getLFSCKMetrics(fields, "test", lfsckRunTimeHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runTime) != 2 || runTime[0].extraLabel != "phase" || runTime[0].extraLabelValue != "phase1" || runTime[0].value != 120 {
+		t.Fatalf("Unexpected run time metrics: %+v", runTime)
+	}
+
+	completed, err := getLFSCKMetrics(fields, "test", lfsckSinceCompletedHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(completed) != 0 {
+		t.Fatalf("Expected no time since the last completion, got %+v", completed)
+	}
+	started, err := getLFSCKMetrics(fields, "test", lfsckSinceStartHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(started) != 1 || started[0].value != 125 {
+		t.Fatalf("Unexpected time since the latest start: %+v", started)
+	}
+
+	fields["success_count"] = "many"
+	if _, err := getLFSCKMetrics(fields, "test", lfsckSuccessHelp); err == nil {
+		t.Fatal("Expected an error for a malformed success_count")
+	}
+}
@@ -0,0 +1,158 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	lov               string = "lov"
+	lovTargetObd      string = "target_obd"
+	lovStripeOffset   string = "stripeoffset"
+	lovQoSMaxAge      string = "qos_maxage"
+	lovQoSPrioFree    string = "qos_prio_free"
+	lovQoSThresholdRR string = "qos_threshold_rr"
+
+	// Help text dedicated to the LOD and LOV files
+	lovTargetsHelp          string = "Number of OSTs of the layout device, by state."
+	lovTargetActiveHelp     string = "Whether the OST is active for the layout device: 1 if it is, 0 otherwise."
+	lovStripeCountHelp      string = "Default number of OSTs a file is striped over."
+	lovStripeSizeHelp       string = "Default size in bytes of a stripe."
+	lovStripeOffsetHelp     string = "Default index of the first OST a file is striped over, -1 to let the layout device choose."
+	lovQoSMaxAgeHelp        string = "Maximum age in seconds of the OST usage the QoS allocator works with."
+	lovQoSPrioFreeHelp      string = "Weight given to the free space of the OSTs by the QoS allocator, as a ratio."
+	lovQoSThresholdRRHelp   string = "Free space imbalance between OSTs above which the QoS allocator replaces round-robin, as a ratio."
+	lovActiveState          string = "active"
+	lovInactiveState        string = "inactive"
+	lovStripeOffsetDefault  uint64 = math.MaxUint64
+	lovTargetObdFieldsCount int    = 3
+)
+
+// lovFiles are the files of the LOD and LOV devices which can't be read as a single number
+var lovFiles = map[string]bool{
+	lovTargetObd:      true,
+	lovStripeOffset:   true,
+	lovQoSMaxAge:      true,
+	lovQoSPrioFree:    true,
+	lovQoSThresholdRR: true,
+}
+
+// lovTarget is an OST of the 'target_obd' file of a layout device.
+type lovTarget struct {
+	name   string
+	active bool
+}
+
+// parseLOVTargets reads the 'target_obd' file of a layout device, such as:
+//
+//	0: lustrefs-OST0000_UUID ACTIVE
+//	1: lustrefs-OST0001_UUID INACTIVE
+func parseLOVTargets(content string) (targets []lovTarget) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < lovTargetObdFieldsCount || !strings.HasSuffix(fields[0], ":") {
+			continue
+		}
+		targets = append(targets, lovTarget{name: strings.TrimSuffix(fields[1], "_UUID"), active: fields[2] == "ACTIVE"})
+	}
+	return targets
+}
+
+func getLOVTargetsMetrics(targets []lovTarget, promName string, helpText string) (metricList []lustreStatsMetric) {
+	if helpText == lovTargetActiveHelp {
+		for _, target := range targets {
+			value := 0.0
+			if target.active {
+				value = 1
+			}
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "ost", extraLabelValue: target.name})
+		}
+		return metricList
+	}
+	active := 0.0
+	for _, target := range targets {
+		if target.active {
+			active++
+		}
+	}
+	return []lustreStatsMetric{
+		{title: promName, help: helpText, value: active, extraLabel: "state", extraLabelValue: lovActiveState},
+		{title: promName, help: helpText, value: float64(len(targets)) - active, extraLabel: "state", extraLabelValue: lovInactiveState},
+	}
+}
+
+// getLOVTunableValue reads the value of the 'stripeoffset' file and of the QoS tunables, which come with a unit such
+// as '5 Sec' or '17%'. Percentages are returned as ratios.
+func getLOVTunableValue(filename string, content string) (float64, error) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty %s content", filename)
+	}
+	if filename == lovStripeOffset {
+		// The offset is printed unsigned, so that letting the layout device choose shows as the maximum value
+		offset, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return strconv.ParseFloat(fields[0], 64)
+		}
+		if offset == lovStripeOffsetDefault {
+			return -1, nil
+		}
+		return float64(offset), nil
+	}
+	value, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+	if strings.HasSuffix(fields[0], "%") {
+		value /= 100
+	}
+	return value, nil
+}
+
+func parseLOVFile(path string, promName string, helpText string) ([]lustreStatsMetric, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	filename := filepath.Base(path)
+	if filename == lovTargetObd {
+		return getLOVTargetsMetrics(parseLOVTargets(string(content)), promName, helpText), nil
+	}
+	value, err := getLOVTunableValue(filename, string(content))
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: value}}, nil
+}
+
+// lovMetricTemplates are the templates of the layout devices, shared by the LODs of the MDTs and the LOVs of the
+// clients
+func (s *lustreProcfsSource) lovMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{lovTargetObd, "lov_targets", lovTargetsHelp, s.gaugeMetric, true, core},
+		{lovTargetObd, "lov_target_active", lovTargetActiveHelp, s.gaugeMetric, true, core},
+		{"stripecount", "lov_default_stripe_count", lovStripeCountHelp, s.gaugeMetric, false, core},
+		{"stripesize", "lov_default_stripe_size_bytes", lovStripeSizeHelp, s.gaugeMetric, false, core},
+		{lovStripeOffset, "lov_default_stripe_offset", lovStripeOffsetHelp, s.gaugeMetric, false, core},
+		{lovQoSMaxAge, "lov_qos_maximum_age_seconds", lovQoSMaxAgeHelp, s.gaugeMetric, false, extended},
+		{lovQoSPrioFree, "lov_qos_prio_free_ratio", lovQoSPrioFreeHelp, s.gaugeMetric, false, extended},
+		{lovQoSThresholdRR, "lov_qos_threshold_rr_ratio", lovQoSThresholdRRHelp, s.gaugeMetric, false, extended},
+	}
+}
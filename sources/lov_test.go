@@ -0,0 +1,82 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLOVTargets(t *testing.T) {
+	content := `0: lustrefs-OST0000_UUID ACTIVE
+1: lustrefs-OST0001_UUID INACTIVE
+2: lustrefs-OST0002_UUID ACTIVE
+`
+	targets := parseLOVTargets(content)
+	expectedTargets := []lovTarget{
+		{name: "lustrefs-OST0000", active: true},
+		{name: "lustrefs-OST0001", active: false},
+		{name: "lustrefs-OST0002", active: true},
+	}
+	if !reflect.DeepEqual(targets, expectedTargets) {
+		t.Fatalf("Unexpected targets: %+v, expected %+v", targets, expectedTargets)
+	}
+
+	metricList := getLOVTargetsMetrics(targets, "lov_targets", lovTargetsHelp)
+	expected := []lustreStatsMetric{
+		{title: "lov_targets", help: lovTargetsHelp, value: 2, extraLabel: "state", extraLabelValue: "active"},
+		{title: "lov_targets", help: lovTargetsHelp, value: 1, extraLabel: "state", extraLabelValue: "inactive"},
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected metrics: %+v, expected %+v", metricList, expected)
+	}
+
+	metricList = getLOVTargetsMetrics(targets, "lov_target_active", lovTargetActiveHelp)
+	expected = []lustreStatsMetric{
+		{title: "lov_target_active", help: lovTargetActiveHelp, value: 1, extraLabel: "ost", extraLabelValue: "lustrefs-OST0000"},
+		{title: "lov_target_active", help: lovTargetActiveHelp, value: 0, extraLabel: "ost", extraLabelValue: "lustrefs-OST0001"},
+		{title: "lov_target_active", help: lovTargetActiveHelp, value: 1, extraLabel: "ost", extraLabelValue: "lustrefs-OST0002"},
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected metrics: %+v, expected %+v", metricList, expected)
+	}
+}
+
+func TestGetLOVTunableValue(t *testing.T) {
+	tests := []struct {
+		filename string
+		content  string
+		expected float64
+	}{
+		{lovStripeOffset, "18446744073709551615\n", -1},
+		{lovStripeOffset, "3\n", 3},
+		{lovStripeOffset, "-1\n", -1},
+		{lovQoSMaxAge, "5 Sec\n", 5},
+		{lovQoSPrioFree, "91%\n", 0.91},
+		{lovQoSThresholdRR, "17%\n", 0.17},
+	}
+	for _, test := range tests {
+		value, err := getLOVTunableValue(test.filename, test.content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != test.expected {
+			t.Fatalf("Unexpected value for %s %q: %v, expected %v", test.filename, test.content, value, test.expected)
+		}
+	}
+
+	if _, err := getLOVTunableValue(lovQoSMaxAge, ""); err == nil {
+		t.Fatal("Expected an error for empty content")
+	}
+}
@@ -0,0 +1,259 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	nrsPoliciesFile string = "nrs_policies"
+	nrsTBFRuleFile  string = "nrs_tbf_rule"
+
+	// Help text dedicated to the 'nrs_policies' file
+	nrsPolicyStateHelp    string = "Current state of the NRS policy: 1 for the reported state, 0 for the others."
+	nrsPolicyFallbackHelp string = "Whether the NRS policy is the fallback policy of the queue: 1 if it is, 0 otherwise."
+	nrsPolicyQueuedHelp   string = "Number of requests currently queued by the NRS policy."
+	nrsPolicyActiveHelp   string = "Number of requests currently being handled from the NRS policy."
+
+	// Help text dedicated to the NRS tunable files
+	nrsCRRNQuantumHelp string = "Number of requests the CRRN policy serves from a client before moving to the next one."
+	nrsORRQuantumHelp  string = "Number of requests the ORR policy serves from an object before moving to the next one."
+	nrsTRRQuantumHelp  string = "Number of requests the TRR policy serves from an OST before moving to the next one."
+	nrsDelayMinHelp    string = "Minimum time in seconds the delay policy holds requests back."
+	nrsDelayMaxHelp    string = "Maximum time in seconds the delay policy holds requests back."
+	nrsDelayPctHelp    string = "Percentage of requests the delay policy holds back."
+
+	// Help text dedicated to the 'nrs_tbf_rule' file
+	nrsTBFRateHelp    string = "Maximum rate in RPCs per second the TBF rule lets through on the CPU partition."
+	nrsTBFInfoHelp    string = "Match expression of the TBF rule, in the 'match' label."
+	nrsTBFClassesHelp string = "Number of TBF classes, such as jobs or clients, currently throttled by the rule on the CPU partition."
+)
+
+var (
+	// nrsPolicyStates are always exported by lustre_nrs_policy_state, so that each of them can be alerted on
+	nrsPolicyStates = []string{"invalid", "stopped", "stopping", "starting", "started"}
+
+	// nrsTunableFiles are the files holding one numeric NRS tunable per queue
+	nrsTunableFiles = map[string]bool{
+		"nrs_crrn_quantum": true,
+		"nrs_orr_quantum":  true,
+		"nrs_trr_quantum":  true,
+		"nrs_delay_min":    true,
+		"nrs_delay_max":    true,
+		"nrs_delay_pct":    true,
+	}
+
+	// nrsTBFRulePattern matches the '{name} {match} {rate}, ref {classes}' lines of a 'nrs_tbf_rule' file. The match
+	// expression is either between braces, such as '{0@lo 192.168.1.[1-128]@tcp}' for the NID, jobid and opcode
+	// policies, or written in the generic expression syntax, such as 'uid={500}&jobid={dd.*}'.
+	nrsTBFRulePattern = regexp.MustCompile(`^(\S+)\s+(\{.*\}|\S.*?)\s+([0-9]+),\s*ref\s+(-?[0-9]+)`)
+
+	// nrsQueues names the queues after the prefixes of their tunables
+	nrsQueues = map[string]string{
+		"reg": "regular",
+		"hp":  "high_priority",
+	}
+)
+
+// nrsPolicy is an entry of the 'nrs_policies' file.
+type nrsPolicy struct {
+	queue    string
+	name     string
+	state    string
+	fallback bool
+	queued   float64
+	active   float64
+}
+
+// parseNRSPolicies reads the policies of every queue listed in a 'nrs_policies' file, such as:
+//
+//	regular_requests:
+//	  - name: fifo
+//	    state: started
+//	    fallback: yes
+//	    queued: 0
+//	    active: 1
+func parseNRSPolicies(content string) (policies []nrsPolicy, err error) {
+	var queue string
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasSuffix(trimmed, "_requests:") {
+			queue = strings.TrimSuffix(trimmed, "_requests:")
+			continue
+		}
+		keyValue := strings.SplitN(strings.TrimPrefix(trimmed, "- "), ":", 2)
+		if len(keyValue) != 2 || queue == "" {
+			continue
+		}
+		key := strings.TrimSpace(keyValue[0])
+		value := strings.TrimSpace(keyValue[1])
+		if key == "name" {
+			policies = append(policies, nrsPolicy{queue: queue, name: value})
+			continue
+		}
+		if len(policies) == 0 {
+			continue
+		}
+		policy := &policies[len(policies)-1]
+		switch key {
+		case "state":
+			policy.state = value
+		case "fallback":
+			policy.fallback = value == "yes"
+		case "queued":
+			if policy.queued, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, err
+			}
+		case "active":
+			if policy.active, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return policies, nil
+}
+
+func getNRSPolicyMetrics(policies []nrsPolicy, helpText string) (metricList []labeledMetric) {
+	labels := []string{"queue", "policy"}
+	for _, policy := range policies {
+		labelValues := []string{policy.queue, policy.name}
+		switch helpText {
+		case nrsPolicyStateHelp:
+			known := false
+			for _, state := range nrsPolicyStates {
+				value := 0.0
+				if state == policy.state {
+					value = 1
+					known = true
+				}
+				metricList = append(metricList, labeledMetric{append(labels, "state"), append(labelValues, state), value})
+			}
+			if !known && policy.state != "" {
+				metricList = append(metricList, labeledMetric{append(labels, "state"), append(labelValues, policy.state), 1})
+			}
+		case nrsPolicyFallbackHelp:
+			value := 0.0
+			if policy.fallback {
+				value = 1
+			}
+			metricList = append(metricList, labeledMetric{labels, labelValues, value})
+		case nrsPolicyQueuedHelp:
+			metricList = append(metricList, labeledMetric{labels, labelValues, policy.queued})
+		case nrsPolicyActiveHelp:
+			metricList = append(metricList, labeledMetric{labels, labelValues, policy.active})
+		}
+	}
+	return metricList
+}
+
+// getNRSTunableMetrics reads an NRS tunable file, which holds a 'reg_{name}:{value}' line for the regular queue and,
+// on services with a high-priority queue, a 'hp_{name}:{value}' line. A bare value applies to the regular queue.
+func getNRSTunableMetrics(content string) (metricList []labeledMetric, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		queue := nrsQueues["reg"]
+		value := line
+		if keyValue := strings.SplitN(line, ":", 2); len(keyValue) == 2 {
+			prefix := strings.SplitN(strings.TrimSpace(keyValue[0]), "_", 2)[0]
+			name, exists := nrsQueues[prefix]
+			if !exists {
+				continue
+			}
+			queue = name
+			value = keyValue[1]
+		}
+		result, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, labeledMetric{[]string{"queue"}, []string{queue}, result})
+	}
+	return metricList, nil
+}
+
+// nrsTBFRule is a rule of the 'nrs_tbf_rule' file, as listed for a CPU partition of a queue.
+type nrsTBFRule struct {
+	queue   string
+	cpt     string
+	name    string
+	match   string
+	rate    float64
+	classes float64
+}
+
+// parseNRSTBFRules reads the rules of every CPU partition of every queue listed in a 'nrs_tbf_rule' file, such as:
+//
+//	regular_requests:
+//	CPT 0:
+//	iozone_user {uid={500}} 100, ref 1
+//	dd_user uid={500}&jobid={dd.*} 50, ref 0
+//	default {*} 10000, ref 0
+func parseNRSTBFRules(content string) (rules []nrsTBFRule, err error) {
+	var queue, cpt string
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasSuffix(trimmed, "_requests:") {
+			queue = strings.TrimSuffix(trimmed, "_requests:")
+			continue
+		}
+		if strings.HasPrefix(trimmed, "CPT ") && strings.HasSuffix(trimmed, ":") {
+			cpt = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(trimmed, "CPT "), ":"))
+			continue
+		}
+		match := nrsTBFRulePattern.FindStringSubmatch(trimmed)
+		if match == nil {
+			continue
+		}
+		expression := match[2]
+		if strings.HasPrefix(expression, "{") && strings.HasSuffix(expression, "}") {
+			expression = expression[1 : len(expression)-1]
+		}
+		rule := nrsTBFRule{queue: queue, cpt: cpt, name: match[1], match: expression}
+		if rule.rate, err = strconv.ParseFloat(match[3], 64); err != nil {
+			return nil, err
+		}
+		if rule.classes, err = strconv.ParseFloat(match[4], 64); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func getNRSTBFRuleMetrics(rules []nrsTBFRule, helpText string) (metricList []labeledMetric) {
+	// The match expression of a rule is the same on every CPU partition, so it is only exported once per queue
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		switch helpText {
+		case nrsTBFRateHelp:
+			metricList = append(metricList, labeledMetric{[]string{"queue", "cpt", "rule"}, []string{rule.queue, rule.cpt, rule.name}, rule.rate})
+		case nrsTBFClassesHelp:
+			metricList = append(metricList, labeledMetric{[]string{"queue", "cpt", "rule"}, []string{rule.queue, rule.cpt, rule.name}, rule.classes})
+		case nrsTBFInfoHelp:
+			key := rule.queue + "/" + rule.name
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			metricList = append(metricList, labeledMetric{[]string{"queue", "rule", "match"}, []string{rule.queue, rule.name, rule.match}, 1})
+		}
+	}
+	return metricList
+}
@@ -0,0 +1,155 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNRSPolicies(t *testing.T) {
+	nrsPolicies := `
+regular_requests:
+  - name: fifo
+    state: started
+    fallback: yes
+    queued: 0                   
+    active: 1                   
+
+  - name: tbf
+    state: started
+    fallback: no
+    queued: 42                  
+    active: 3                   
+
+high_priority_requests:
+  - name: fifo
+    state: stopped
+    fallback: yes
+    queued: 0                   
+    active: 0                   
+`
+	policies, err := parseNRSPolicies(nrsPolicies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []nrsPolicy{
+		{queue: "regular", name: "fifo", state: "started", fallback: true, queued: 0, active: 1},
+		{queue: "regular", name: "tbf", state: "started", fallback: false, queued: 42, active: 3},
+		{queue: "high_priority", name: "fifo", state: "stopped", fallback: true, queued: 0, active: 0},
+	}
+	if !reflect.DeepEqual(policies, expected) {
+		t.Fatalf("Unexpected policies: %+v, expected %+v", policies, expected)
+	}
+
+	states := getNRSPolicyMetrics(policies[:1], nrsPolicyStateHelp)
+	if len(states) != len(nrsPolicyStates) {
+		t.Fatalf("Unexpected number of states: %d, expected %d", len(states), len(nrsPolicyStates))
+	}
+	for _, state := range states {
+		expectedLabels := []string{"queue", "policy", "state"}
+		if !reflect.DeepEqual(state.labels, expectedLabels) {
+			t.Fatalf("Unexpected labels: %v, expected %v", state.labels, expectedLabels)
+		}
+		if (state.labelValues[2] == "started") != (state.value == 1) {
+			t.Fatalf("Unexpected value %f for state %q", state.value, state.labelValues[2])
+		}
+	}
+
+	queued := getNRSPolicyMetrics(policies, nrsPolicyQueuedHelp)
+	if len(queued) != 3 || queued[1].value != 42 || !reflect.DeepEqual(queued[1].labelValues, []string{"regular", "tbf"}) {
+		t.Fatalf("Unexpected queued metrics: %+v", queued)
+	}
+}
+
+func TestGetNRSTunableMetrics(t *testing.T) {
+	metricList, err := getNRSTunableMetrics("reg_quantum:256\nhp_quantum:16\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []labeledMetric{
+		{[]string{"queue"}, []string{"regular"}, 256},
+		{[]string{"queue"}, []string{"high_priority"}, 16},
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected metrics: %+v, expected %+v", metricList, expected)
+	}
+
+	metricList, err = getNRSTunableMetrics("")
+	if err != nil || metricList != nil {
+		t.Fatalf("Unexpected metrics for an empty file: %+v, %v", metricList, err)
+	}
+	if _, err = getNRSTunableMetrics("reg_delay_pct:abc"); err == nil {
+		t.Fatal("Expected an error for a non-numeric value")
+	}
+}
+
+func TestParseNRSTBFRules(t *testing.T) {
+	ruleFile := `regular_requests:
+CPT 0:
+iozone_user {uid={500}} 100, ref 1
+default {*} 10000, ref 0
+CPT 1:
+iozone_user {uid={500}} 100, ref 2
+default {*} 10000, ref 0
+high_priority_requests:
+CPT 0:
+default {*} 10000, ref 0
+`
+	rules, err := parseNRSTBFRules(ruleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 5 {
+		t.Fatalf("Unexpected number of rules: %d, expected 5", len(rules))
+	}
+	expected := nrsTBFRule{queue: "regular", cpt: "1", name: "iozone_user", match: "uid={500}", rate: 100, classes: 2}
+	if rules[2] != expected {
+		t.Fatalf("Unexpected rule: %+v, expected %+v", rules[2], expected)
+	}
+
+	rates := getNRSTBFRuleMetrics(rules, nrsTBFRateHelp)
+	if len(rates) != 5 || !reflect.DeepEqual(rates[4].labelValues, []string{"high_priority", "0", "default"}) {
+		t.Fatalf("Unexpected rate metrics: %+v", rates)
+	}
+	// The match expression of each rule is exported once per queue
+	info := getNRSTBFRuleMetrics(rules, nrsTBFInfoHelp)
+	expectedInfo := []labeledMetric{
+		{[]string{"queue", "rule", "match"}, []string{"regular", "iozone_user", "uid={500}"}, 1},
+		{[]string{"queue", "rule", "match"}, []string{"regular", "default", "*"}, 1},
+		{[]string{"queue", "rule", "match"}, []string{"high_priority", "default", "*"}, 1},
+	}
+	if !reflect.DeepEqual(info, expectedInfo) {
+		t.Fatalf("Unexpected info metrics: %+v, expected %+v", info, expectedInfo)
+	}
+
+	// Rules written in the generic expression syntax have no braces around their match expression
+	rules, err = parseNRSTBFRules(`regular_requests:
+CPT 0:
+dd_user uid={500}&jobid={dd.*} 50, ref 3
+nid_rule {0@lo 192.168.1.[1-128]@tcp} 200, ref 0
+default * 10000, ref 0
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRules := []nrsTBFRule{
+		{queue: "regular", cpt: "0", name: "dd_user", match: "uid={500}&jobid={dd.*}", rate: 50, classes: 3},
+		{queue: "regular", cpt: "0", name: "nid_rule", match: "0@lo 192.168.1.[1-128]@tcp", rate: 200, classes: 0},
+		{queue: "regular", cpt: "0", name: "default", match: "*", rate: 10000, classes: 0},
+	}
+	if !reflect.DeepEqual(rules, expectedRules) {
+		t.Fatalf("Unexpected rules: %+v, expected %+v", rules, expectedRules)
+	}
+}
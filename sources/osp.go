@@ -0,0 +1,109 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	ospPreallocStatus string = "prealloc_status"
+
+	// Help text dedicated to the osp devices
+	ospPreallocStatusHelp   string = "Status of the object precreation on the OST, 0 if healthy and a negative errno otherwise, named by the 'errno' label."
+	ospPreallocNextIDHelp   string = "Next precreated object ID the MDT will hand out for the OST."
+	ospPreallocLastIDHelp   string = "Last object ID precreated on the OST."
+	ospPreallocReservedHelp string = "Number of precreated objects reserved for files being created."
+	ospCreateCountHelp      string = "Number of objects the MDT asks the OST to precreate at once."
+	ospMaxCreateCountHelp   string = "Maximum number of objects the MDT asks the OST to precreate at once."
+	ospSyncInFlightHelp     string = "Number of object destroy and setattr RPCs in flight to the OST."
+	ospSyncInProgressHelp   string = "Number of object destroy and setattr changes being processed for the OST."
+	ospSyncChangesHelp      string = "Number of object destroy and setattr changes waiting to be sent to the OST."
+	ospDestroysInFlightHelp string = "Number of object destroys in flight to the OST."
+	ospOldSyncProcessedHelp string = "Returns '1' if the changes left from before the last MDT restart were sent to the OST."
+	ospReservedLowHelp      string = "Free space in megabytes of the OST below which the MDT stops allocating objects on it."
+	ospReservedHighHelp     string = "Free space in megabytes of the OST above which the MDT allocates objects on it again."
+	ospHealthyErrno         string = "none"
+)
+
+// ospErrnos names the errors object precreation reports the most
+var ospErrnos = map[int]string{
+	2:   "ENOENT",
+	5:   "EIO",
+	11:  "EAGAIN",
+	12:  "ENOMEM",
+	16:  "EBUSY",
+	19:  "ENODEV",
+	22:  "EINVAL",
+	28:  "ENOSPC",
+	30:  "EROFS",
+	107: "ENOTCONN",
+	108: "ESHUTDOWN",
+	110: "ETIMEDOUT",
+	115: "EINPROGRESS",
+	122: "EDQUOT",
+}
+
+// ospErrnoName returns the name of the errno of a 'prealloc_status' value. Errnos unknown to this exporter are
+// named by their number.
+func ospErrnoName(status int) string {
+	if status == 0 {
+		return ospHealthyErrno
+	}
+	if status < 0 {
+		status = -status
+	}
+	if name, exists := ospErrnos[status]; exists {
+		return name
+	}
+	return strconv.Itoa(status)
+}
+
+func getOSPPreallocStatusMetrics(content string, promName string, helpText string) ([]lustreStatsMetric, error) {
+	status, err := strconv.Atoi(strings.TrimSpace(content))
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: float64(status), extraLabel: "errno", extraLabelValue: ospErrnoName(status)}}, nil
+}
+
+func parseOSPPreallocStatusFile(path string, promName string, helpText string) ([]lustreStatsMetric, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return getOSPPreallocStatusMetrics(string(content), promName, helpText)
+}
+
+// ospMetricTemplates are the templates of the osp devices an MDT reaches its OSTs through
+func (s *lustreProcfsSource) ospMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{ospPreallocStatus, "osp_prealloc_status", ospPreallocStatusHelp, s.gaugeMetric, true, core},
+		{"prealloc_next_id", "osp_prealloc_next_id", ospPreallocNextIDHelp, s.gaugeMetric, false, core},
+		{"prealloc_last_id", "osp_prealloc_last_id", ospPreallocLastIDHelp, s.gaugeMetric, false, core},
+		{"prealloc_reserved", "osp_prealloc_reserved_objects", ospPreallocReservedHelp, s.gaugeMetric, false, extended},
+		{"create_count", "osp_create_count", ospCreateCountHelp, s.gaugeMetric, false, extended},
+		{"max_create_count", "osp_maximum_create_count", ospMaxCreateCountHelp, s.gaugeMetric, false, extended},
+		{"sync_in_flight", "osp_sync_in_flight", ospSyncInFlightHelp, s.gaugeMetric, false, core},
+		{"sync_in_progress", "osp_sync_in_progress", ospSyncInProgressHelp, s.gaugeMetric, false, core},
+		{"sync_changes", "osp_sync_changes", ospSyncChangesHelp, s.gaugeMetric, false, core},
+		{"destroys_in_flight", "osp_destroys_in_flight", ospDestroysInFlightHelp, s.gaugeMetric, false, core},
+		{"old_sync_processed", "osp_old_sync_processed", ospOldSyncProcessedHelp, s.gaugeMetric, false, extended},
+		{"reserved_mb_low", "osp_reserved_low_megabytes", ospReservedLowHelp, s.gaugeMetric, false, extended},
+		{"reserved_mb_high", "osp_reserved_high_megabytes", ospReservedHighHelp, s.gaugeMetric, false, extended},
+	}
+}
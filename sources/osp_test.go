@@ -0,0 +1,44 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"testing"
+)
+
+func TestGetOSPPreallocStatusMetrics(t *testing.T) {
+	tests := []struct {
+		content string
+		value   float64
+		errno   string
+	}{
+		{"0\n", 0, "none"},
+		{"-28\n", -28, "ENOSPC"},
+		{"-5\n", -5, "EIO"},
+		{"-200\n", -200, "200"},
+	}
+	for _, test := range tests {
+		metricList, err := getOSPPreallocStatusMetrics(test.content, "osp_prealloc_status", ospPreallocStatusHelp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != test.value || metricList[0].extraLabel != "errno" || metricList[0].extraLabelValue != test.errno {
+			t.Fatalf("Unexpected metrics for %q: %+v, expected %v with errno %q", test.content, metricList, test.value, test.errno)
+		}
+	}
+
+	if _, err := getOSPPreallocStatusMetrics("ENOSPC\n", "osp_prealloc_status", ospPreallocStatusHelp); err == nil {
+		t.Fatal("Expected an error for a non-numeric status")
+	}
+}
@@ -30,8 +30,7 @@ const (
 )
 
 var (
-	numRegexPattern   = regexp.MustCompile(`[0-9]*\.[0-9]+|[0-9]+`)
-	jobidRegexPattern = regexp.MustCompile(`job_id:\s*(.*\.[0-9]+|[0-9_]+)`)
+	numRegexPattern = regexp.MustCompile(`[0-9]*\.[0-9]+|[0-9]+`)
 )
 
 type prometheusType func([]string, []string, string, string, float64) prometheus.Metric
@@ -96,11 +95,6 @@ func regexCaptureNumbers(textToMatch string) (matchedNumbers []string) {
 	return matchedNumbers
 }
 
-func regexCaptureJobids(textToMatch string) (matchedJobids []string) {
-	matchedJobids = jobidRegexPattern.FindStringSubmatch(textToMatch)
-	return
-}
-
 func parseFileElements(path string, directoryDepth int) (name string, nodeName string, err error) {
 	pathElements := strings.Split(path, "/")
 	pathLen := len(pathElements)
@@ -15,10 +15,12 @@ package sources
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -36,6 +38,9 @@ const (
 	jobStatsHelp     string = "Number of operations the filesystem has performed."
 	statsHelp        string = "Number of operations the filesystem has performed."
 
+	// Help text dedicated to the job_stats cardinality controls
+	jobStatsSuppressedHelp string = "Number of jobs dropped by the job_stats limits during the last scrape."
+
 	// Help text dedicated to the 'brw_stats' file
 	pagesPerBlockRWHelp    string = "Total number of pages per block RPC."
 	discontiguousPagesHelp string = "Total number of logical discontinuities per RPC."
@@ -68,6 +73,7 @@ const (
 	//repeated strings replaced by constants
 	mdStats          string = "md_stats"
 	encryptPagePools string = "encrypt_page_pools"
+	jobStats         string = "job_stats"
 )
 
 var (
@@ -108,6 +114,9 @@ func init() {
 type lustreProcfsSource struct {
 	lustreProcMetrics []lustreProcMetric
 	basePath          string
+	jobIDs            *jobIDDecoder
+	jobInfo           *jobInfoCache
+	importStates      *importStateTracker
 }
 
 func (s *lustreProcfsSource) generateOSTMetricTemplates(filter string) {
@@ -141,6 +150,18 @@ func (s *lustreProcfsSource) generateOSTMetricTemplates(filter string) {
 			{"lfsck_speed_limit", "lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", s.gaugeMetric, false, extended},
 			{"num_exports", "exports_total", "Total number of times the pool has been exported", s.counterMetric, false, core},
 			{"precreate_batch", "precreate_batch", "Maximum number of objects that can be included in a single transaction", s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_status", recoveryStatusHelp, s.gaugeMetric, true, core},
+			{recoveryStatus, "recovery_start_time_seconds", recoveryStartHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_duration_seconds", recoveryDurationHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_time_remaining_seconds", recoveryTimeRemainingHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_completed_clients", recoveryCompletedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_clients", recoveryClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_connected_clients", recoveryConnectedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_evicted_clients", recoveryEvictedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_req_replay_clients", recoveryReqReplayClientsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_lock_replay_clients", recoveryLockReplayClientsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_replayed_requests", recoveryReplayedRequestsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_queued_requests", recoveryQueuedRequestsHelp, s.gaugeMetric, false, extended},
 			{"recovery_time_hard", "recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", s.gaugeMetric, false, extended},
 			{"recovery_time_soft", "recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", s.gaugeMetric, false, extended},
 			{"soft_sync_limit", "soft_sync_limit", "Number of RPCs necessary before triggering a sync", s.gaugeMetric, false, extended},
@@ -175,7 +196,10 @@ func (s *lustreProcfsSource) generateOSTMetricTemplates(filter string) {
 			{"pool/shrink_request", "shrink_requests_total", "Number of shrinks that have been requested", s.counterMetric, false, extended},
 			{"pool/slv", "server_lock_volume", "Current value for server lock volume (SLV)", s.gaugeMetric, false, extended},
 		},
+		"ost/OSS/*": s.serviceMetricTemplates(),
 	}
+	metricMap["obdfilter/*"] = append(metricMap["obdfilter/*"], s.lfsckMetricTemplates(lfsckLayout)...)
+	metricMap["obdfilter/*"] = append(metricMap["obdfilter/*"], s.siteMetricTemplates()...)
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -196,12 +220,34 @@ func (s *lustreProcfsSource) generateMDTMetricTemplates(filter string) {
 			{"kbytesfree", "free_kilobytes", "Number of kilobytes allocated to the pool", s.gaugeMetric, false, core},
 			{"kbytestotal", "capacity_kilobytes", "Capacity of the pool in kilobytes", s.gaugeMetric, false, core},
 		},
+		"mdd/*": append([]lustreHelpStruct{
+			{changelogUsers, "changelog_current_index", changelogCurrentIndexHelp, s.gaugeMetric, false, core},
+			{changelogUsers, "changelog_user_index", changelogUserIndexHelp, s.gaugeMetric, false, core},
+			{changelogUsers, "changelog_user_lag_records", changelogUserLagHelp, s.gaugeMetric, false, core},
+			{changelogUsers, "changelog_user_idle_seconds", changelogUserIdleHelp, s.gaugeMetric, false, core},
+		}, s.lfsckMetricTemplates(lfsckLayout, lfsckNamespace)...),
 		"mdt/*": {
 			{mdStats, "stats_total", statsHelp, s.counterMetric, true, core},
 			{"num_exports", "exports_total", "Total number of times the pool has been exported", s.counterMetric, false, core},
 			{"job_stats", "job_stats_total", jobStatsHelp, s.counterMetric, true, core},
+			{renameStats, "rename_stats_total", renameStatsHelp, s.counterMetric, false, core},
+			{recoveryStatus, "recovery_status", recoveryStatusHelp, s.gaugeMetric, true, core},
+			{recoveryStatus, "recovery_start_time_seconds", recoveryStartHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_duration_seconds", recoveryDurationHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_time_remaining_seconds", recoveryTimeRemainingHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_completed_clients", recoveryCompletedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_clients", recoveryClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_connected_clients", recoveryConnectedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_evicted_clients", recoveryEvictedClientsHelp, s.gaugeMetric, false, core},
+			{recoveryStatus, "recovery_req_replay_clients", recoveryReqReplayClientsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_lock_replay_clients", recoveryLockReplayClientsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_replayed_requests", recoveryReplayedRequestsHelp, s.gaugeMetric, false, extended},
+			{recoveryStatus, "recovery_queued_requests", recoveryQueuedRequestsHelp, s.gaugeMetric, false, extended},
 		},
 	}
+	metricMap["mdt/*"] = append(metricMap["mdt/*"], s.siteMetricTemplates()...)
+	metricMap["lod/*"] = s.lovMetricTemplates()
+	metricMap["osp/*-osc-MDT*"] = s.ospMetricTemplates()
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -234,7 +280,9 @@ func (s *lustreProcfsSource) generateMGSMetricTemplates(filter string) {
 }
 
 func (s *lustreProcfsSource) generateMDSMetricTemplates(filter string) {
-	metricMap := map[string][]lustreHelpStruct{}
+	metricMap := map[string][]lustreHelpStruct{
+		"mds/MDS/*": s.serviceMetricTemplates(),
+	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -283,6 +331,8 @@ func (s *lustreProcfsSource) generateClientMetricTemplates(filter string) {
 			{"rpc_stats", "rpcs_offset", offsetHelp, s.gaugeMetric, false, core},
 		},
 	}
+	// Only the LOVs of the client mounts, as older releases link the LODs of the MDTs under lov as well
+	metricMap["lov/*-clilov-*"] = s.lovMetricTemplates()
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -323,9 +373,182 @@ func (s *lustreProcfsSource) generateGenericMetricTemplates(filter string) {
 	}
 }
 
+func (s *lustreProcfsSource) generateExportMetricTemplates(filter string) {
+	// The exports of OSTs and MDTs share the same stats format
+	statsList := []lustreHelpStruct{
+		{exportStats, "export_read_samples_total", readSamplesHelp, s.counterMetric, false, core},
+		{exportStats, "export_read_minimum_size_bytes", readMinimumHelp, s.gaugeMetric, false, extended},
+		{exportStats, "export_read_maximum_size_bytes", readMaximumHelp, s.gaugeMetric, false, extended},
+		{exportStats, "export_read_bytes_total", readTotalHelp, s.counterMetric, false, core},
+		{exportStats, "export_write_samples_total", writeSamplesHelp, s.counterMetric, false, core},
+		{exportStats, "export_write_minimum_size_bytes", writeMinimumHelp, s.gaugeMetric, false, extended},
+		{exportStats, "export_write_maximum_size_bytes", writeMaximumHelp, s.gaugeMetric, false, extended},
+		{exportStats, "export_write_bytes_total", writeTotalHelp, s.counterMetric, false, core},
+		{exportStats, "export_stats_total", statsHelp, s.counterMetric, true, core},
+	}
+	metricMap := map[string][]lustreHelpStruct{
+		"obdfilter/*": statsList,
+		"mdt/*": append(statsList[:len(statsList):len(statsList)], []lustreHelpStruct{
+			{exportLdlmStats, "export_ldlm_requests_total", ldlmRequestsHelp, s.counterMetric, true, core},
+			{exportLdlmStats, "export_ldlm_latency_seconds_total", ldlmLatencyHelp, s.counterMetric, true, extended},
+			{exportOpenFiles, "export_open_files", openFilesHelp, s.gaugeMetric, false, core},
+			{exportReplyData, "export_reply_data_slots", replyCountHelp, s.gaugeMetric, false, extended},
+			{exportReplyData, "export_reply_data_slots_maximum", replyMaximumHelp, s.gaugeMetric, false, extended},
+			{exportReplyData, "export_reply_released_by_xid_total", replyReleasedByXIDHelp, s.counterMetric, false, extended},
+			{exportReplyData, "export_reply_released_by_tag_total", replyReleasedByTagHelp, s.counterMetric, false, extended},
+		}...),
+	}
+	componentMap := map[string]string{
+		"obdfilter/*": "ost",
+		"mdt/*":       "mdt",
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, componentMap[path], path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+}
+
+func (s *lustreProcfsSource) generateImportMetricTemplates(filter string) {
+	metricList := []lustreHelpStruct{
+		{importFile, "import_state", importStateHelp, s.gaugeMetric, true, core},
+		{importFile, "import_connection_info", importConnectionHelp, s.gaugeMetric, true, core},
+		{importFile, "import_connection_attempts_total", importConnectionAttemptsHelp, s.counterMetric, false, core},
+		{importFile, "import_rpcs_inflight", importInflightHelp, s.gaugeMetric, false, core},
+		{importFile, "import_rpcs_unregistering", importUnregisteringHelp, s.gaugeMetric, false, extended},
+		{importFile, "import_rpcs_timeouts", importTimeoutsHelp, s.gaugeMetric, false, core},
+		{importFile, "import_average_wait_time_seconds", importAverageWaitHelp, s.gaugeMetric, false, core},
+		{importFile, "import_service_estimate_seconds", importServiceEstimateHelp, s.gaugeMetric, false, extended},
+		{importFile, "import_network_estimate_seconds", importNetworkEstimateHelp, s.gaugeMetric, false, extended},
+		{importStateFile, "import_state_transitions_total", importTransitionsHelp, s.counterMetric, true, core},
+		{importStateFile, "client_evictions_total", importEvictionsHelp, s.counterMetric, false, core},
+		{timeoutsFile, "import_adaptive_timeout_seconds", atCurrentHelp, s.gaugeMetric, true, core},
+		{timeoutsFile, "import_adaptive_timeout_worst_seconds", atWorstHelp, s.gaugeMetric, true, core},
+		{timeoutsFile, "import_adaptive_timeout_worst_time_seconds", atWorstTimeHelp, s.gaugeMetric, true, extended},
+		{timeoutsFile, "import_adaptive_timeout_history_seconds", atHistoryHelp, s.gaugeMetric, true, extended},
+		{timeoutsFile, "import_last_reply_time_seconds", atLastReplyHelp, s.gaugeMetric, false, extended},
+	}
+	// Clients import their MDTs, OSTs and MGS, MDTs import their OSTs through osp devices, and the servers import
+	// MDT0000 through lwp devices. The component is the device type, as osc and osp devices share the same names. Only
+	// the osc devices of the client mounts, named after their hexadecimal instance, as older releases link the osp
+	// devices of the MDTs under osc as well.
+	componentMap := map[string]string{
+		"mdc/*":               "mdc",
+		"osc/*-osc-[0-9a-f]*": "osc",
+		"mgc/*":               "mgc",
+		"osp/*":               "osp",
+		"lwp/*":               "lwp",
+	}
+	for path, component := range componentMap {
+		for _, item := range metricList {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, component, path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+}
+
+func (s *lustreProcfsSource) generateQuotaMetricTemplates(filter string) {
+	metricMap := map[string][]lustreHelpStruct{
+		"qmt/*/*": {
+			{"glb-usr", "quota_limit_hard_bytes", quotaHardLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-usr", "quota_limit_hard_inodes", quotaHardLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-usr", "quota_limit_soft_bytes", quotaSoftLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-usr", "quota_limit_soft_inodes", quotaSoftLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-usr", "quota_granted_bytes", quotaGrantedBytesHelp, s.gaugeMetric, false, extended},
+			{"glb-usr", "quota_granted_inodes", quotaGrantedInodesHelp, s.gaugeMetric, false, extended},
+			{"glb-grp", "quota_limit_hard_bytes", quotaHardLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-grp", "quota_limit_hard_inodes", quotaHardLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-grp", "quota_limit_soft_bytes", quotaSoftLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-grp", "quota_limit_soft_inodes", quotaSoftLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-grp", "quota_granted_bytes", quotaGrantedBytesHelp, s.gaugeMetric, false, extended},
+			{"glb-grp", "quota_granted_inodes", quotaGrantedInodesHelp, s.gaugeMetric, false, extended},
+			{"glb-prj", "quota_limit_hard_bytes", quotaHardLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-prj", "quota_limit_hard_inodes", quotaHardLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-prj", "quota_limit_soft_bytes", quotaSoftLimitBytesHelp, s.gaugeMetric, false, core},
+			{"glb-prj", "quota_limit_soft_inodes", quotaSoftLimitInodesHelp, s.gaugeMetric, false, core},
+			{"glb-prj", "quota_granted_bytes", quotaGrantedBytesHelp, s.gaugeMetric, false, extended},
+			{"glb-prj", "quota_granted_inodes", quotaGrantedInodesHelp, s.gaugeMetric, false, extended},
+		},
+	}
+	if QuotaDerived {
+		for _, filename := range []string{"glb-usr", "glb-grp", "glb-prj"} {
+			metricMap["qmt/*/*"] = append(metricMap["qmt/*/*"],
+				lustreHelpStruct{filename, "quota_hard_limit_utilization_ratio", quotaHardUtilizationHelp, s.gaugeMetric, false, core},
+				lustreHelpStruct{filename, "quota_soft_limit_utilization_ratio", quotaSoftUtilizationHelp, s.gaugeMetric, false, core},
+				lustreHelpStruct{filename, "quota_grace_remaining_seconds", quotaGraceRemainingHelp, s.gaugeMetric, false, core},
+			)
+		}
+	}
+	// Quota slaves run on the OSD of every OST and MDT
+	slaveMetrics := []lustreHelpStruct{
+		{"quota_slave/acct_user", "quota_usage_bytes", quotaUsageBytesHelp, s.gaugeMetric, false, core},
+		{"quota_slave/acct_user", "quota_usage_inodes", quotaUsageInodesHelp, s.gaugeMetric, false, core},
+		{"quota_slave/acct_group", "quota_usage_bytes", quotaUsageBytesHelp, s.gaugeMetric, false, core},
+		{"quota_slave/acct_group", "quota_usage_inodes", quotaUsageInodesHelp, s.gaugeMetric, false, core},
+		{"quota_slave/acct_project", "quota_usage_bytes", quotaUsageBytesHelp, s.gaugeMetric, false, core},
+		{"quota_slave/acct_project", "quota_usage_inodes", quotaUsageInodesHelp, s.gaugeMetric, false, core},
+		{quotaSlaveInfoFile, "quota_slave_info", quotaSlaveInfoHelp, s.gaugeMetric, false, core},
+	}
+	slaveComponents := map[string]string{
+		"osd-*/*-OST*": "ost",
+		"osd-*/*-MDT*": "mdt",
+	}
+	for path, component := range slaveComponents {
+		for _, item := range slaveMetrics {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, component, path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, "qmt", path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+}
+
+func (s *lustreProcfsSource) generateHSMMetricTemplates(filter string) {
+	metricMap := map[string][]lustreHelpStruct{
+		"mdt/*": {
+			{hsmControlFile, "hsm_coordinator_state", hsmCoordinatorStateHelp, s.gaugeMetric, false, core},
+			{hsmActionsFile, "hsm_actions", hsmActionsHelp, s.gaugeMetric, false, core},
+			{hsmActiveRequestsFile, "hsm_active_requests", hsmActiveRequestsHelp, s.gaugeMetric, false, core},
+			{"hsm/max_requests", "hsm_max_requests", "Maximum number of HSM requests copytool agents can handle at the same time", s.gaugeMetric, false, core},
+			{hsmAgentsFile, "hsm_agents", hsmAgentsHelp, s.gaugeMetric, false, core},
+			{hsmAgentsFile, "hsm_agent_active_requests", hsmAgentCurrentHelp, s.gaugeMetric, false, extended},
+			{hsmAgentsFile, "hsm_agent_requests_total", hsmAgentRequestsHelp, s.counterMetric, false, extended},
+			{hsmPolicyFile, "hsm_policy", hsmPolicyHelp, s.gaugeMetric, false, extended},
+			{"hsm/active_request_timeout", "hsm_active_request_timeout_seconds", "Time in seconds after which an HSM request without progress from its copytool agent is canceled", s.gaugeMetric, false, extended},
+			{"hsm/grace_delay", "hsm_grace_delay_seconds", "Time in seconds finished HSM requests are kept in the coordinator log", s.gaugeMetric, false, extended},
+			{"hsm/loop_period", "hsm_loop_period_seconds", "Time in seconds between two runs of the HSM coordinator", s.gaugeMetric, false, extended},
+			{"hsm/default_archive_id", "hsm_default_archive_id", "Archive ID used by HSM requests which don't set one", s.gaugeMetric, false, extended},
+		},
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, "mdt", path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+}
+
 func newLustreSource() LustreSource {
 	var l lustreProcfsSource
 	l.basePath = filepath.Join(ProcLocation, "fs/lustre")
+	l.jobIDs = newJobIDDecoder(readJobIDTemplate())
+	l.jobInfo = newJobInfoCacheFromFlags()
+	warnMissingJobStatsAggregations(l.jobLabels(), JobStatsAggregations)
 	//control which node metrics you pull via flags
 	if OstEnabled != disabled {
 		l.generateOSTMetricTemplates(OstEnabled)
@@ -345,14 +568,57 @@ func newLustreSource() LustreSource {
 	if GenericEnabled != disabled {
 		l.generateGenericMetricTemplates(GenericEnabled)
 	}
+	if ExportEnabled != disabled {
+		l.generateExportMetricTemplates(ExportEnabled)
+	}
+	if ImportEnabled != disabled {
+		l.importStates = newImportStateTracker()
+		l.generateImportMetricTemplates(ImportEnabled)
+	}
+	if QuotaEnabled != disabled {
+		l.generateQuotaMetricTemplates(QuotaEnabled)
+	}
+	if HsmEnabled != disabled {
+		l.generateHSMMetricTemplates(HsmEnabled)
+	}
 	return &l
 }
 
 func (s *lustreProcfsSource) Update(ch chan<- prometheus.Metric) (err error) {
 	var metricType string
 	var directoryDepth int
+	jobStatsMetrics := make(map[string][]lustreProcMetric)
+	exportMetrics := make(map[string][]lustreProcMetric)
+	importStateMetrics := make(map[string][]lustreProcMetric)
+	var labeledMetrics []lustreProcMetric
+	quotaDerivedMetrics := make(map[string][]lustreProcMetric)
 
 	for _, metric := range s.lustreProcMetrics {
+		if metric.filename == jobStats {
+			// job_stats files can be very large, so they are read once per target for all of their metrics below
+			jobStatsMetrics[metric.path] = append(jobStatsMetrics[metric.path], metric)
+			continue
+		}
+		if metric.filename == importStateFile {
+			// State transitions are counted once per import below, before being emitted by all of their metrics
+			importStateMetrics[metric.path] = append(importStateMetrics[metric.path], metric)
+			continue
+		}
+		if quotaDerivedHelps[metric.helpText] {
+			// Derived quota series rank the IDs of each quota master file, so they are read once per file below
+			quotaDerivedMetrics[filepath.Join(metric.path, metric.filename)] = append(quotaDerivedMetrics[filepath.Join(metric.path, metric.filename)], metric)
+			continue
+		}
+		if hasOwnLabels(metric) {
+			// These files are labeled with more than their target and a single extra label, so they are read below
+			labeledMetrics = append(labeledMetrics, metric)
+			continue
+		}
+		if strings.HasPrefix(metric.filename, exportsDir) {
+			// Clients are ranked across all of a target's exports, so they are read once per target below
+			exportMetrics[metric.path] = append(exportMetrics[metric.path], metric)
+			continue
+		}
 		directoryDepth = strings.Count(metric.filename, "/")
 		paths, err := filepath.Glob(filepath.Join(s.basePath, metric.path, metric.filename))
 		if err != nil {
@@ -375,17 +641,6 @@ func (s *lustreProcfsSource) Update(ch chan<- prometheus.Metric) (err error) {
 				if err != nil {
 					return err
 				}
-			case "job_stats":
-				err = s.parseJobStats(metric.source, "job_stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, jobid string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid"}, []string{nodeType, nodeName, jobid}, name, helpText, value)
-					} else {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid", extraLabel}, []string{nodeType, nodeName, jobid, extraLabelValue}, name, helpText, value)
-					}
-				})
-				if err != nil {
-					return err
-				}
 			default:
 				if metric.filename == stats {
 					metricType = stats
@@ -393,6 +648,18 @@ func (s *lustreProcfsSource) Update(ch chan<- prometheus.Metric) (err error) {
 					metricType = mdStats
 				} else if metric.filename == encryptPagePools {
 					metricType = encryptPagePools
+				} else if metric.filename == recoveryStatus {
+					metricType = recoveryStatus
+				} else if metric.filename == importFile {
+					metricType = importFile
+				} else if metric.filename == changelogUsers {
+					metricType = changelogUsers
+				} else if metric.filename == siteStats || metric.filename == hashStats {
+					metricType = metric.filename
+				} else if lovFiles[metric.filename] {
+					metricType = lov
+				} else if metric.filename == ospPreallocStatus {
+					metricType = ospPreallocStatus
 				}
 				err = s.parseFile(metric.source, metricType, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
 					if extraLabelValue == "" {
@@ -407,9 +674,131 @@ func (s *lustreProcfsSource) Update(ch chan<- prometheus.Metric) (err error) {
 			}
 		}
 	}
+	var scrapeTime time.Time
+	if jobLedger != nil {
+		scrapeTime = jobLedger.now()
+	}
+	for metricPath, metrics := range jobStatsMetrics {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, metricPath, jobStats))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			err = s.parseJobStats(metrics[0].source, path, metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+				ch <- metricFunc(labels, labelValues, name, helpText, value)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if jobLedger != nil {
+		// The jobs of the targets that are gone, such as after an unmount or a failover, are complete as well
+		jobLedger.expire(scrapeTime)
+	}
+	for _, metric := range labeledMetrics {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, metric.path, metric.filename))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			err = s.parseLabeledFile(metric, path, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+				ch <- metricFunc(labels, labelValues, name, helpText, value)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for metricPath, metrics := range quotaDerivedMetrics {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, metricPath))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			err = s.parseQuotaDerived(metrics[0].source, path, metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+				ch <- metricFunc(labels, labelValues, name, helpText, value)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for metricPath, metrics := range importStateMetrics {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, metricPath, importFile))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			err = s.parseImportStates(metrics[0].source, path, metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+				ch <- metricFunc(labels, labelValues, name, helpText, value)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for metricPath, metrics := range exportMetrics {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, metricPath))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			err = s.parseExports(metrics[0].source, path, metrics, func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+				ch <- metricFunc(labels, labelValues, name, helpText, value)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// hasOwnLabels returns whether the series of a template carry labels of their own, such as the service of a PTLRPC
+// service file or the portal of an import estimate, which parseFile can't emit.
+func hasOwnLabels(metric lustreProcMetric) bool {
+	_, isQuotaGlobalFile := quotaGlobalFiles[metric.filename]
+	_, isQuotaAccountingFile := quotaAccountingFiles[metric.filename]
+	return servicePaths[metric.path] || metric.filename == timeoutsFile || metric.filename == lfsckLayout || metric.filename == lfsckNamespace || hsmFiles[metric.filename] || metric.filename == renameStats || isQuotaGlobalFile || isQuotaAccountingFile || metric.filename == quotaSlaveInfoFile
+}
+
+// labeledMetric is a value along with the labels it is exported with, on top of the labels of its file.
+type labeledMetric struct {
+	labels      []string
+	labelValues []string
+	value       float64
+}
+
+// emitLabeledMetrics emits values with their own labels on top of the labels of their file.
+func emitLabeledMetrics(metric lustreProcMetric, labels []string, labelValues []string, metricList []labeledMetric, handler func(prometheusType, []string, []string, string, string, float64)) {
+	for _, item := range metricList {
+		handler(metric.metricFunc, append(labels[:len(labels):len(labels)], item.labels...), append(labelValues[:len(labelValues):len(labelValues)], item.labelValues...), metric.promName, metric.helpText, item.value)
+	}
+}
+
+// parseLabeledFile emits the values of a template whose series carry labels of their own.
+func (s *lustreProcfsSource) parseLabeledFile(metric lustreProcMetric, path string, handler func(prometheusType, []string, []string, string, string, float64)) error {
+	switch {
+	case servicePaths[metric.path]:
+		return s.parseServiceFile(metric.source, path, metric, handler)
+	case metric.filename == timeoutsFile:
+		return s.parseImportTimeouts(metric.source, path, metric, handler)
+	case metric.filename == lfsckLayout || metric.filename == lfsckNamespace:
+		return s.parseLFSCKFile(metric.source, path, metric, handler)
+	case hsmFiles[metric.filename]:
+		return s.parseHSMFile(metric.source, path, metric, handler)
+	case metric.filename == renameStats:
+		return s.parseRenameStatsFile(metric.source, path, metric, handler)
+	case metric.filename == quotaSlaveInfoFile:
+		return s.parseQuotaSlaveInfo(metric.source, path, metric, handler)
+	case quotaAccountingFiles[metric.filename] != "":
+		return s.parseQuotaUsage(metric.source, path, metric, handler)
+	default:
+		return s.parseQuotaLimits(metric.source, path, metric, handler)
+	}
+}
+
 func getStatsOperationMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
 	operationSlice := []multistatParsingStruct{
 		{pattern: "open", index: 1},
@@ -564,148 +953,184 @@ func parseStatsFile(helpText string, promName string, path string, hasMultipleVa
 	return metricList, nil
 }
 
-func getJobStatsIOMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	// opMap matches the given helpText value with the placement of the numeric fields within each metric line.
-	// For example, the number of samples is the first number in the line and has a helpText of readSamplesHelp,
-	// hence the 'index' value of 0. 'pattern' is the regex capture pattern for the desired line.
-	opMap := map[string]multistatParsingStruct{
-		readSamplesHelp:  {index: 0, pattern: "read_bytes"},
-		readMinimumHelp:  {index: 1, pattern: "read_bytes"},
-		readMaximumHelp:  {index: 2, pattern: "read_bytes"},
-		readTotalHelp:    {index: 3, pattern: "read_bytes"},
-		writeSamplesHelp: {index: 0, pattern: "write_bytes"},
-		writeMinimumHelp: {index: 1, pattern: "write_bytes"},
-		writeMaximumHelp: {index: 2, pattern: "write_bytes"},
-		writeTotalHelp:   {index: 3, pattern: "write_bytes"},
+func getJobStatsIOMetrics(job jobStatsRecord, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
+	// opMap matches the given helpText value with the operation and field of the job record that holds its value.
+	opMap := map[string]struct {
+		operation string
+		field     jobStatsField
+	}{
+		readSamplesHelp:  {"read_bytes", jobStatsSamples},
+		readMinimumHelp:  {"read_bytes", jobStatsMinimum},
+		readMaximumHelp:  {"read_bytes", jobStatsMaximum},
+		readTotalHelp:    {"read_bytes", jobStatsSum},
+		writeSamplesHelp: {"write_bytes", jobStatsSamples},
+		writeMinimumHelp: {"write_bytes", jobStatsMinimum},
+		writeMaximumHelp: {"write_bytes", jobStatsMaximum},
+		writeTotalHelp:   {"write_bytes", jobStatsSum},
 	}
 	// If the metric isn't located in the map, don't try to parse a value for it.
-	if _, exists := opMap[helpText]; !exists {
+	item, exists := opMap[helpText]
+	if !exists {
 		return nil, nil
 	}
-	pattern := opMap[helpText].pattern
-	opStat := regexCaptureString(pattern+": .*", jobBlock)
-	opNumbers := regexCaptureNumbers(opStat)
-	if len(opNumbers) < 1 {
+	op, exists := job.operation(item.operation)
+	if !exists {
 		return nil, nil
 	}
-	result, err := strconv.ParseFloat(strings.TrimSpace(opNumbers[opMap[helpText].index]), 64)
-	if err != nil {
-		return nil, err
-	}
 	l := lustreStatsMetric{
 		title:           promName,
 		help:            helpText,
-		value:           result,
+		value:           op.value(item.field),
 		extraLabel:      "",
 		extraLabelValue: "",
 	}
-	metricList = append(metricList, lustreJobsMetric{jobID, l})
+	metricList = append(metricList, lustreJobsMetric{job.jobID, l})
 
-	return metricList, err
-}
-
-func getJobNum(jobBlock string) (jobID string, err error) {
-	jobID = regexCaptureString("job_id: .*", jobBlock)
-	matched := regexCaptureJobids(jobID)
-	if len(matched) < 2 {
-		return "", nil
-	}
-	return matched[1], nil
+	return metricList, nil
 }
 
-func getJobStatsOperationMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	operationSlice := []multistatParsingStruct{
-		{index: 0, pattern: "open"},
-		{index: 0, pattern: "close"},
-		{index: 0, pattern: "mknod"},
-		{index: 0, pattern: "link"},
-		{index: 0, pattern: "unlink"},
-		{index: 0, pattern: "mkdir"},
-		{index: 0, pattern: "rmdir"},
-		{index: 0, pattern: "rename"},
-		{index: 0, pattern: "getattr"},
-		{index: 0, pattern: "setattr"},
-		{index: 0, pattern: "getxattr"},
-		{index: 0, pattern: "setxattr"},
-		{index: 0, pattern: "statfs"},
-		{index: 0, pattern: "sync"},
-		{index: 0, pattern: "samedir_rename"},
-		{index: 0, pattern: "crossdir_rename"},
-		{index: 0, pattern: "punch"},
-		{index: 0, pattern: "destroy"},
-		{index: 0, pattern: "create"},
-		{index: 0, pattern: "get_info"},
-		{index: 0, pattern: "set_info"},
-		{index: 0, pattern: "quotactl"},
-	}
-	for _, operation := range operationSlice {
-		opStat := regexCaptureString(operation.pattern+": .*", jobBlock)
-		opNumbers := regexCaptureStrings("[0-9]*\\.[0-9]+|[0-9]+", opStat)
-		if len(opNumbers) < 1 {
+func getJobStatsOperationMetrics(job jobStatsRecord, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
+	for _, op := range job.operations {
+		// read_bytes and write_bytes are covered by the dedicated IO metrics
+		if op.unit == "bytes" {
 			continue
 		}
-		var result float64
-		result, err = strconv.ParseFloat(strings.TrimSpace(opNumbers[operation.index]), 64)
-		if err != nil {
-			return nil, err
-		}
 		l := lustreStatsMetric{
 			title:           promName,
 			help:            helpText,
-			value:           result,
+			value:           op.samples,
 			extraLabel:      "operation",
-			extraLabelValue: operation.pattern,
+			extraLabelValue: op.name,
 		}
-		metricList = append(metricList, lustreJobsMetric{jobID, l})
+		metricList = append(metricList, lustreJobsMetric{job.jobID, l})
 	}
-	return metricList, err
+	return metricList, nil
 }
 
-func parseJobStatsText(jobStats string, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric, err error) {
-	jobs := regexCaptureStrings("(?ms:job_id:.*?$.*?(-|\\z))", jobStats)
-	if len(jobs) < 1 {
-		return nil, nil
+func getJobStatsMetrics(job jobStatsRecord, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric, err error) {
+	if hasMultipleVals {
+		return getJobStatsOperationMetrics(job, promName, helpText)
 	}
-	var jobList []lustreJobsMetric
-	for _, job := range jobs {
-		jobID, err := getJobNum(job)
-		if err != nil {
-			return nil, err
-		}
-		if hasMultipleVals {
-			jobList, err = getJobStatsOperationMetrics(job, jobID, promName, helpText)
-		} else {
-			jobList, err = getJobStatsIOMetrics(job, jobID, promName, helpText)
-		}
-		if err != nil {
-			return nil, err
-		}
-		if jobList != nil {
-			metricList = append(metricList, jobList...)
-		}
+	return getJobStatsIOMetrics(job, promName, helpText)
+}
+
+// jobLabels returns the labels of the per-job series: the labels decoded from the job IDs, followed by the job
+// metadata labels when enabled.
+func (s *lustreProcfsSource) jobLabels() []string {
+	labels := s.jobIDs.labels
+	if s.jobInfo != nil {
+		labels = append(labels[:len(labels):len(labels)], jobInfoLabels...)
 	}
-	return metricList, nil
+	return labels
 }
 
-func (s *lustreProcfsSource) parseJobStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, float64, string, string)) (err error) {
-	_, nodeName, err := parseFileElements(path, directoryDepth)
+// jobLabelValues returns the values of jobLabels for the given job ID.
+func (s *lustreProcfsSource) jobLabelValues(jobID string) []string {
+	labelValues := s.jobIDs.decode(jobID)
+	if s.jobInfo != nil {
+		labelValues = append(labelValues, s.jobInfo.labelValues(labelValues[0])...)
+	}
+	return labelValues
+}
+
+// parseJobStats streams a single job_stats file and emits the values of every given template for each selected job,
+// followed by the per-user, per-group or per-project roll-ups and the values and number of the jobs dropped by the limits.
+func (s *lustreProcfsSource) parseJobStats(nodeType string, path string, metrics []lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, 0)
 	if err != nil {
 		return err
 	}
-	jobStatsBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	jobStatsFile, err := os.Open(filepath.Clean(path))
 	if err != nil {
 		return err
 	}
+	defer jobStatsFile.Close()
 
-	jobStatsFile := string(jobStatsBytes[:])
+	// emit exports a job record, or an aggregated record, under the metric names of the templates with the 'job'
+	// prefix replaced by the given kind.
+	emit := func(job jobStatsRecord, kind string, labels []string, labelValues []string) error {
+		labels = append([]string{"component", "target"}, labels...)
+		labelValues = append([]string{nodeType, nodeName}, labelValues...)
+		for _, metric := range metrics {
+			promName := kind + strings.TrimPrefix(metric.promName, "job")
+			metricList, err := getJobStatsMetrics(job, promName, metric.helpText, metric.hasMultipleVals)
+			if err != nil {
+				return err
+			}
+			for _, item := range metricList {
+				if item.extraLabelValue == "" {
+					handler(metric.metricFunc, labels, labelValues, item.title, item.help, item.value)
+				} else {
+					handler(metric.metricFunc, append(labels[:len(labels):len(labels)], item.extraLabel), append(labelValues[:len(labelValues):len(labelValues)], item.extraLabelValue), item.title, item.help, item.value)
+				}
+			}
+		}
+		return nil
+	}
+	labels := s.jobLabels()
+	emitJob := func(job jobStatsRecord) error {
+		return emit(job, "job", labels, s.jobLabelValues(job.jobID))
+	}
 
-	metricList, err := parseJobStatsText(jobStatsFile, promName, helpText, hasMultipleVals)
+	selector := newJobStatsSelector(JobStatsTopN, jobStatsSortByFor(JobStatsSortBy, nodeType), JobStatsMinActivity)
+	if JobStatsTTL > 0 {
+		selector.minSnapshotTime = float64(time.Now().Add(-JobStatsTTL).Unix())
+	}
+	aggregators := newJobStatsAggregators(labels, JobStatsAggregations)
+	var trackedJobs []jobStatsRecord
+	err = parseJobStats(jobStatsFile, func(job jobStatsRecord) error {
+		if jobLedger != nil {
+			trackedJobs = append(trackedJobs, job)
+		}
+		if len(aggregators) > 0 {
+			labelValues := s.jobLabelValues(job.jobID)
+			for _, aggregator := range aggregators {
+				aggregator.add(labelValues, job)
+			}
+		}
+		if !JobStatsPerJob || !selector.add(job) {
+			return nil
+		}
+		return emitJob(job)
+	})
 	if err != nil {
 		return err
 	}
-
-	for _, item := range metricList {
-		handler(nodeType, item.jobID, nodeName, item.lustreStatsMetric.title, item.lustreStatsMetric.help, item.lustreStatsMetric.value, item.lustreStatsMetric.extraLabel, item.lustreStatsMetric.extraLabelValue)
+	if jobLedger != nil {
+		jobLedger.update(nodeType, nodeName, trackedJobs)
+	}
+	if JobStatsPerJob {
+		for _, job := range selector.kept() {
+			if err = emitJob(job); err != nil {
+				return err
+			}
+		}
+		// The suppressed jobs are exported as gauges without a job ID, as their values drop whenever jobs move in
+		// or out of the top N, and as a job could be named 'other' as well
+		if selector.suppressed > 0 {
+			for _, metric := range metrics {
+				promName := jobStatsSuppressedPrefix + strings.TrimSuffix(strings.TrimPrefix(metric.promName, "job_"), "_total")
+				metricList, err := getJobStatsMetrics(selector.other, promName, metric.helpText, metric.hasMultipleVals)
+				if err != nil {
+					return err
+				}
+				for _, item := range metricList {
+					if item.extraLabelValue == "" {
+						handler(s.gaugeMetric, []string{"component", "target"}, []string{nodeType, nodeName}, item.title, item.help, item.value)
+					} else {
+						handler(s.gaugeMetric, []string{"component", "target", item.extraLabel}, []string{nodeType, nodeName, item.extraLabelValue}, item.title, item.help, item.value)
+					}
+				}
+			}
+		}
+		handler(s.gaugeMetric, []string{"component", "target"}, []string{nodeType, nodeName}, "exporter_job_stats_suppressed_jobs", jobStatsSuppressedHelp, float64(selector.suppressed))
+	}
+	for _, aggregator := range aggregators {
+		for _, record := range aggregator.aggregated() {
+			if err = emit(record, aggregator.kind, []string{aggregator.label}, []string{record.jobID}); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -777,6 +1202,62 @@ func (s *lustreProcfsSource) parseFile(nodeType string, metricType string, path
 		for _, metric := range metricList {
 			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
 		}
+	case recoveryStatus:
+		fields, err := readKeyValueFile(path)
+		if err != nil {
+			return err
+		}
+		metricList, err := getRecoveryStatusMetrics(fields, promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case importFile:
+		fields, err := readImportFile(path)
+		if err != nil {
+			return err
+		}
+		metricList, err := getImportMetrics(fields, promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case lov:
+		metricList, err := parseLOVFile(path, promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case ospPreallocStatus:
+		metricList, err := parseOSPPreallocStatusFile(path, promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case siteStats, hashStats:
+		metricList, err := parseSiteFile(metricType, path, promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case changelogUsers:
+		record, err := readChangelogUsers(path)
+		if err != nil {
+			return err
+		}
+		for _, metric := range getChangelogUsersMetrics(record, promName, helpText) {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
 	}
 	return nil
 }
@@ -14,44 +14,12 @@
 package sources
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
-func TestGetJobNum(t *testing.T) {
-	testString := "job_id: 1234"
-	expected := "1234"
-
-	jobID, err := getJobNum(testString)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if jobID != expected {
-		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", expected, jobID)
-	}
-
-	testString = "job_id: ABCD"
-	expected = ""
-
-	jobID, err = getJobNum(testString)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if jobID != expected {
-		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", expected, jobID)
-	}
-
-	testString = "job_id:  abc .0123 .-_+ AB.1000  "
-	expected = "abc .0123 .-_+ AB.1000"
-
-	jobID, err = getJobNum(testString)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if jobID != expected {
-		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", expected, jobID)
-	}
-}
-
 func TestGetJobStats(t *testing.T) {
 	testJobBlock := `- job_id:          29
   snapshot_time:   1493326943
@@ -67,12 +35,15 @@ func TestGetJobStats(t *testing.T) {
   get_info:        { samples:           8, unit:  reqs }
   set_info:        { samples:           9, unit:  reqs }
   quotactl:        { samples:           10, unit:  reqs }`
-	testJobID := "29"
 	testPromName := "job_write_bytes_total"
 	testHelpText := writeTotalHelp
 	expected := float64(274726912)
 
-	metricList, err := getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
+	testJob, err := parseTestJob(testJobBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metricList, err := getJobStatsIOMetrics(testJob, testPromName, testHelpText)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -88,11 +59,14 @@ func TestGetJobStats(t *testing.T) {
 	if metricList[0].title != testPromName {
 		t.Fatalf("Retrieved an unexpected name. Expected: %s, Got: %s", testPromName, metricList[0].title)
 	}
+	if metricList[0].jobID != "29" {
+		t.Fatalf("Retrieved an unexpected Job ID. Expected: %s, Got: %s", "29", metricList[0].jobID)
+	}
 
 	testPromName = "job_stats_total"
 	testHelpText = jobStatsHelp
 
-	metricList, err = getJobStatsOperationMetrics(testJobBlock, testJobID, testPromName, testHelpText)
+	metricList, err = getJobStatsOperationMetrics(testJob, testPromName, testHelpText)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -112,7 +86,7 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "dne"
 	testHelpText = "Help for DNE"
 
-	metricList, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
+	metricList, err = getJobStatsIOMetrics(testJob, testPromName, testHelpText)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -124,8 +98,75 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "job_write_bytes_total"
 	testHelpText = writeTotalHelp
 
-	_, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
+	testJob, err = parseTestJob(testJobBlock)
 	if err != nil {
 		t.Fatal(err)
 	}
+	metricList, err = getJobStatsIOMetrics(testJob, testPromName, testHelpText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(metricList); l != 0 {
+		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 0, l)
+	}
+}
+
+func TestParseJobStatsSuppressed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jobstats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	path := filepath.Join(dir, "lustrefs-MDT0000", "job_stats")
+	if err = os.Mkdir(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(path, []byte(`job_stats:
+- job_id:          other
+  snapshot_time:   1493326943
+  open:            { samples:           1, unit:  usecs }
+- job_id:          dd.0
+  snapshot_time:   1493326943
+  open:            { samples:           5, unit:  usecs }
+- job_id:          cp.0
+  snapshot_time:   1493326943
+  open:            { samples:           2, unit:  usecs }
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(topN int) { JobStatsTopN = topN }(JobStatsTopN)
+	JobStatsTopN = 1
+	s := &lustreProcfsSource{jobIDs: newJobIDDecoder("")}
+	metrics := []lustreProcMetric{newLustreProcMetric(jobStats, "job_stats_total", "mdt", "mdt/*", jobStatsHelp, true, s.counterMetric)}
+	values := make(map[string]float64)
+	err = s.parseJobStats("mdt", path, metrics, func(_ prometheusType, labels []string, labelValues []string, name string, _ string, value float64) {
+		key := name
+		for i := 2; i < len(labels); i++ {
+			key += " " + labels[i] + "=" + labelValues[i]
+		}
+		values[key] = value
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The job named 'other' and the jobs suppressed by the top N limit don't share a series
+	expected := map[string]float64{
+		"job_stats_total jobid=dd.0 operation=open":          5,
+		"exporter_job_stats_suppressed_stats operation=open": 3,
+		"exporter_job_stats_suppressed_jobs":                 2,
+	}
+	if len(values) != len(expected) {
+		t.Fatalf("Retrieved unexpected series. Expected: %v, Got: %v", expected, values)
+	}
+	for key, value := range expected {
+		if values[key] != value {
+			t.Fatalf("Retrieved an unexpected value for %s. Expected: %f, Got: %v", key, value, values)
+		}
+	}
 }
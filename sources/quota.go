@@ -0,0 +1,368 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Help text dedicated to the 'glb-*' files of the quota master
+	quotaHardLimitBytesHelp  string = "Hard block quota limit of the ID in bytes. 0 means no limit."
+	quotaHardLimitInodesHelp string = "Hard inode quota limit of the ID. 0 means no limit."
+	quotaSoftLimitBytesHelp  string = "Soft block quota limit of the ID in bytes. 0 means no limit."
+	quotaSoftLimitInodesHelp string = "Soft inode quota limit of the ID. 0 means no limit."
+	quotaGrantedBytesHelp    string = "Block quota granted to the quota slaves for the ID in bytes."
+	quotaGrantedInodesHelp   string = "Inode quota granted to the quota slaves for the ID."
+
+	// Help text dedicated to the 'quota_slave' files of the OSDs
+	quotaUsageBytesHelp  string = "Space used by the ID on the target in bytes."
+	quotaUsageInodesHelp string = "Number of inodes used by the ID on the target."
+	quotaSlaveInfoHelp   string = "Quota types enforced by the quota slave of the target and the state of its connection to the quota master, in the 'enabled' and 'conn_to_master' labels."
+	quotaSlaveInfoFile   string = "quota_slave/info"
+
+	// Help text dedicated to the series derived from the limits of the quota master and the usage of the quota slaves
+	quotaHardUtilizationHelp string = "Usage of the ID divided by its hard limit: the space granted to the quota slaves on dt pools, and the inodes used on the local MDTs on md pools."
+	quotaSoftUtilizationHelp string = "Usage of the ID divided by its soft limit: the space granted to the quota slaves on dt pools, and the inodes used on the local MDTs on md pools."
+	quotaGraceRemainingHelp  string = "Time in seconds left before the soft limit of the ID is enforced as a hard limit. 0 once the grace period has expired."
+	quotaSuppressedHelp      string = "Number of IDs with limits for which derived quota series were dropped during the last scrape."
+
+	// quotaGraceTimeMask strips the flags Lustre stores in the upper bits of the grace time
+	quotaGraceTimeMask int64 = 1<<48 - 1
+)
+
+var (
+	// QuotaEnabled specifies whether to collect quota metrics
+	QuotaEnabled string
+	// QuotaIDs restricts the per-ID quota series to the given IDs, either for every quota type ('1000') or for a
+	// single one ('usr:1000'). Every ID is exported when empty.
+	QuotaIDs []string
+	// QuotaNonZeroLimitsOnly restricts the per-ID quota series to the IDs with a hard or soft limit
+	QuotaNonZeroLimitsOnly bool
+	// QuotaDerived specifies whether to compute utilization and grace series from the limits and the usage of each ID
+	QuotaDerived bool
+	// QuotaDerivedMaxIDs is the number of IDs with the highest utilization for which derived series are exported per
+	// pool and quota type. 0 exports every ID with a limit.
+	QuotaDerivedMaxIDs int
+
+	// quotaNow returns the current time, against which grace times are compared
+	quotaNow = time.Now
+
+	// quotaDerivedHelps are the templates computed by parseQuotaDerived
+	quotaDerivedHelps = map[string]bool{
+		quotaHardUtilizationHelp: true,
+		quotaSoftUtilizationHelp: true,
+		quotaGraceRemainingHelp:  true,
+	}
+
+	// quotaGlobalFiles are the global index files of a quota master pool, named after the quota type they hold
+	quotaGlobalFiles = map[string]string{
+		"glb-usr": "usr",
+		"glb-grp": "grp",
+		"glb-prj": "prj",
+	}
+
+	// quotaAccountingFiles are the accounting files of a quota slave, named after the quota type they hold
+	quotaAccountingFiles = map[string]string{
+		"quota_slave/acct_user":    "usr",
+		"quota_slave/acct_group":   "grp",
+		"quota_slave/acct_project": "prj",
+	}
+)
+
+// quotaEntry is an ID of a quota index file, along with its numeric fields.
+type quotaEntry struct {
+	id     string
+	fields map[string]float64
+}
+
+// parseQuotaIndex reads the IDs of a quota index file, such as the 'glb-*' files of the quota master or the
+// 'acct_*' files of the quota slaves. Each ID starts with a '- id: {id}' line, followed by a line holding its fields
+// in braces, such as 'limits: { hard: 0, soft: 0, granted: 0, time: 604800 }'.
+func parseQuotaIndex(content string) (entries []quotaEntry, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- id:") {
+			id := strings.TrimSpace(strings.TrimPrefix(trimmed, "- id:"))
+			entries = append(entries, quotaEntry{id: id, fields: make(map[string]float64)})
+			continue
+		}
+		start := strings.Index(trimmed, "{")
+		end := strings.LastIndex(trimmed, "}")
+		if len(entries) == 0 || start < 0 || end < start {
+			continue
+		}
+		for _, field := range strings.Split(trimmed[start+1:end], ",") {
+			keyValue := strings.SplitN(field, ":", 2)
+			if len(keyValue) != 2 {
+				continue
+			}
+			value, err := strconv.ParseFloat(strings.TrimSpace(keyValue[1]), 64)
+			if err != nil {
+				return nil, err
+			}
+			entries[len(entries)-1].fields[strings.TrimSpace(keyValue[0])] = value
+		}
+	}
+	return entries, nil
+}
+
+func readQuotaIndex(path string) ([]quotaEntry, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return parseQuotaIndex(string(content))
+}
+
+// quotaIDSelected returns whether the series of an ID of the given quota type are exported, according to QuotaIDs.
+func quotaIDSelected(quotaType string, id string) bool {
+	if len(QuotaIDs) == 0 {
+		return true
+	}
+	for _, selected := range QuotaIDs {
+		if selected == id || selected == quotaType+":"+id {
+			return true
+		}
+	}
+	return false
+}
+
+// quotaHasLimits returns whether an ID of a quota master has a hard or soft limit.
+func quotaHasLimits(entry quotaEntry) bool {
+	return entry.fields["hard"] > 0 || entry.fields["soft"] > 0
+}
+
+// parseQuotaLimits emits the values of a template for every selected ID of a global index file of a quota master.
+func (s *lustreProcfsSource) parseQuotaLimits(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	pool, nodeName, err := parseFileElements(filepath.Dir(path), 0)
+	if err != nil {
+		return err
+	}
+	entries, err := readQuotaIndex(path)
+	if err != nil {
+		return err
+	}
+	// fieldMap matches the given helpText value with the field holding its value, the pools it applies to and the
+	// multiplier converting it: dt pools are in kilobytes and md pools in inodes
+	fieldMap := map[string]struct {
+		field      string
+		poolPrefix string
+		multiplier float64
+	}{
+		quotaHardLimitBytesHelp:  {"hard", "dt-", 1024},
+		quotaHardLimitInodesHelp: {"hard", "md-", 1},
+		quotaSoftLimitBytesHelp:  {"soft", "dt-", 1024},
+		quotaSoftLimitInodesHelp: {"soft", "md-", 1},
+		quotaGrantedBytesHelp:    {"granted", "dt-", 1024},
+		quotaGrantedInodesHelp:   {"granted", "md-", 1},
+	}
+	field, exists := fieldMap[metric.helpText]
+	if !exists || !strings.HasPrefix(pool, field.poolPrefix) {
+		return nil
+	}
+	quotaType := quotaGlobalFiles[metric.filename]
+	for _, entry := range entries {
+		if !quotaIDSelected(quotaType, entry.id) || (QuotaNonZeroLimitsOnly && !quotaHasLimits(entry)) {
+			continue
+		}
+		value, exists := entry.fields[field.field]
+		if !exists {
+			continue
+		}
+		handler(metric.metricFunc, []string{"component", "target", "pool", "type", "id"}, []string{nodeType, nodeName, pool, quotaType, entry.id}, metric.promName, metric.helpText, value*field.multiplier)
+	}
+	return nil
+}
+
+// parseQuotaUsage emits the values of a template for every selected ID of an accounting file of a quota slave.
+// Accounting files of quota types the backend doesn't track only hold 'not supported', and have no IDs.
+func (s *lustreProcfsSource) parseQuotaUsage(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, 1)
+	if err != nil {
+		return err
+	}
+	entries, err := readQuotaIndex(path)
+	if err != nil {
+		return err
+	}
+	// fieldMap matches the given helpText value with the field holding its value and the multiplier converting it
+	// to the unit of the metric
+	fieldMap := map[string]struct {
+		field      string
+		multiplier float64
+	}{
+		quotaUsageBytesHelp:  {"kbytes", 1024},
+		quotaUsageInodesHelp: {"inodes", 1},
+	}
+	field, exists := fieldMap[metric.helpText]
+	if !exists {
+		return nil
+	}
+	quotaType := quotaAccountingFiles[metric.filename]
+	for _, entry := range entries {
+		if !quotaIDSelected(quotaType, entry.id) {
+			continue
+		}
+		value, exists := entry.fields[field.field]
+		if !exists {
+			continue
+		}
+		handler(metric.metricFunc, []string{"component", "target", "type", "id"}, []string{nodeType, nodeName, quotaType, entry.id}, metric.promName, metric.helpText, value*field.multiplier)
+	}
+	return nil
+}
+
+// parseQuotaSlaveInfo emits the quota enforcement and master connection state found in the info file of a quota
+// slave as labels.
+func (s *lustreProcfsSource) parseQuotaSlaveInfo(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, 1)
+	if err != nil {
+		return err
+	}
+	fields, err := readKeyValueFile(path)
+	if err != nil {
+		return err
+	}
+	enabled, exists := fields["quota enabled"]
+	if !exists {
+		return nil
+	}
+	handler(metric.metricFunc, []string{"component", "target", "enabled", "conn_to_master"}, []string{nodeType, nodeName, enabled, fields["conn to master"]}, metric.promName, metric.helpText, 1)
+	return nil
+}
+
+// readLocalInodeUsage adds up the inodes used by every ID of a quota type on the quota slaves of the local MDTs. The
+// result is nil when none of them tracks the quota type.
+func (s *lustreProcfsSource) readLocalInodeUsage(quotaType string) (map[string]float64, error) {
+	var usage map[string]float64
+	for filename, fileType := range quotaAccountingFiles {
+		if fileType != quotaType {
+			continue
+		}
+		paths, err := filepath.Glob(filepath.Join(s.basePath, "osd-*", "*-MDT*", filename))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			entries, err := readQuotaIndex(path)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				if usage == nil {
+					usage = make(map[string]float64)
+				}
+				usage[entry.id] += entry.fields["inodes"]
+			}
+		}
+	}
+	return usage, nil
+}
+
+// quotaDerivedEntry is an ID of a quota master along with its usage, in the unit of its limits.
+type quotaDerivedEntry struct {
+	quotaEntry
+	usage float64
+}
+
+// utilization returns the usage of the ID divided by its hard limit, or by its soft limit when it only has a soft one.
+func (e quotaDerivedEntry) utilization() float64 {
+	if e.fields["hard"] > 0 {
+		return e.usage / e.fields["hard"]
+	}
+	return e.usage / e.fields["soft"]
+}
+
+// parseQuotaDerived computes the utilization and grace series of the IDs of a global index file of a quota master,
+// from their limits and their usage. The OSTs are usually served by other nodes than the quota master, so the usage of
+// dt pools is the space granted to the quota slaves, which follows their usage, while the usage of md pools is read
+// from the quota slaves of the local MDTs. Utilization isn't computed for md pools none of them tracks, and only the
+// IDs with the highest utilization are kept. The number of IDs that were dropped comes last.
+func (s *lustreProcfsSource) parseQuotaDerived(nodeType string, path string, metrics []lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	pool, nodeName, err := parseFileElements(filepath.Dir(path), 0)
+	if err != nil {
+		return err
+	}
+	quotaType := quotaGlobalFiles[filepath.Base(path)]
+	entries, err := readQuotaIndex(path)
+	if err != nil {
+		return err
+	}
+	tracked := true
+	var usage map[string]float64
+	if strings.HasPrefix(pool, "md-") {
+		if usage, err = s.readLocalInodeUsage(quotaType); err != nil {
+			return err
+		}
+		tracked = usage != nil
+	}
+
+	var limited []quotaDerivedEntry
+	for _, entry := range entries {
+		// The limits of ID 0 are the defaults of the other IDs, and its time is the grace period itself
+		if entry.id == "0" || !quotaHasLimits(entry) || !quotaIDSelected(quotaType, entry.id) {
+			continue
+		}
+		used := entry.fields["granted"]
+		if usage != nil {
+			used = usage[entry.id]
+		}
+		limited = append(limited, quotaDerivedEntry{entry, used})
+	}
+	sort.SliceStable(limited, func(i, j int) bool {
+		return limited[i].utilization() > limited[j].utilization()
+	})
+	suppressed := 0
+	if QuotaDerivedMaxIDs > 0 && len(limited) > QuotaDerivedMaxIDs {
+		suppressed = len(limited) - QuotaDerivedMaxIDs
+		limited = limited[:QuotaDerivedMaxIDs]
+	}
+
+	labels := []string{"component", "target", "pool", "type", "id"}
+	now := quotaNow().Unix()
+	for _, metric := range metrics {
+		for _, entry := range limited {
+			labelValues := []string{nodeType, nodeName, pool, quotaType, entry.id}
+			switch metric.helpText {
+			case quotaHardUtilizationHelp:
+				if tracked && entry.fields["hard"] > 0 {
+					handler(metric.metricFunc, labels, labelValues, metric.promName, metric.helpText, entry.usage/entry.fields["hard"])
+				}
+			case quotaSoftUtilizationHelp:
+				if tracked && entry.fields["soft"] > 0 {
+					handler(metric.metricFunc, labels, labelValues, metric.promName, metric.helpText, entry.usage/entry.fields["soft"])
+				}
+			case quotaGraceRemainingHelp:
+				// The grace time is only set while the ID is over its soft limit
+				graceTime := int64(entry.fields["time"]) & quotaGraceTimeMask
+				if entry.fields["soft"] <= 0 || graceTime <= 0 {
+					continue
+				}
+				remaining := float64(graceTime - now)
+				if remaining < 0 {
+					remaining = 0
+				}
+				handler(metric.metricFunc, labels, labelValues, metric.promName, metric.helpText, remaining)
+			}
+		}
+	}
+	handler(s.gaugeMetric, []string{"component", "target", "pool", "type"}, []string{nodeType, nodeName, pool, quotaType}, "exporter_quota_derived_suppressed_ids", quotaSuppressedHelp, float64(suppressed))
+	return nil
+}
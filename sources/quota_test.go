@@ -0,0 +1,315 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseQuotaIndex(t *testing.T) {
+	globalIndex := `global_pool0_dt_usr
+- id:      0
+  limits:  { hard:                    0, soft:                    0, granted:                    0, time:               604800 }
+- id:      1000
+  limits:  { hard:             20971520, soft:             10485760, granted:              4194304, time:                    0 }
+`
+	entries, err := parseQuotaIndex(globalIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []quotaEntry{
+		{id: "0", fields: map[string]float64{"hard": 0, "soft": 0, "granted": 0, "time": 604800}},
+		{id: "1000", fields: map[string]float64{"hard": 20971520, "soft": 10485760, "granted": 4194304, "time": 0}},
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Unexpected entries: %+v, expected %+v", entries, expected)
+	}
+	if quotaHasLimits(entries[0]) || !quotaHasLimits(entries[1]) {
+		t.Fatal("Unexpected limits detection")
+	}
+
+	if _, err = parseQuotaIndex("- id: 0\n  limits: { hard: abc }\n"); err == nil {
+		t.Fatal("Expected an error for a non-numeric field")
+	}
+}
+
+func TestQuotaIDSelected(t *testing.T) {
+	defer func() { QuotaIDs = nil }()
+
+	QuotaIDs = nil
+	if !quotaIDSelected("usr", "1000") {
+		t.Fatal("Every ID should be selected without an allowlist")
+	}
+
+	QuotaIDs = []string{"1000", "prj:42"}
+	testCases := []struct {
+		quotaType string
+		id        string
+		expected  bool
+	}{
+		{"usr", "1000", true},
+		{"grp", "1000", true},
+		{"prj", "42", true},
+		{"usr", "42", false},
+		{"usr", "0", false},
+	}
+	for _, tc := range testCases {
+		if selected := quotaIDSelected(tc.quotaType, tc.id); selected != tc.expected {
+			t.Fatalf("Unexpected selection of %s:%s: %t, expected %t", tc.quotaType, tc.id, selected, tc.expected)
+		}
+	}
+}
+
+func TestParseQuotaLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota_limits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	files := map[string]string{
+		"dt-0x0/glb-usr": `global_pool0_dt_usr
+- id:      1000
+  limits:  { hard:                 1000, soft:                  500, granted:                  800, time:                    0 }
+`,
+		"md-0x0/glb-usr": `global_pool0_md_usr
+- id:      1000
+  limits:  { hard:                  100, soft:                   50, granted:                   10, time:                    0 }
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, "lustrefs-QMT0000", name)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results := make(map[string]float64)
+	handler := func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+		results[name+"/"+labelValues[2]] = value
+	}
+	s := &lustreProcfsSource{}
+	metrics := []lustreProcMetric{
+		{filename: "glb-usr", promName: "hard_bytes", helpText: quotaHardLimitBytesHelp},
+		{filename: "glb-usr", promName: "hard_inodes", helpText: quotaHardLimitInodesHelp},
+		{filename: "glb-usr", promName: "granted_bytes", helpText: quotaGrantedBytesHelp},
+		{filename: "glb-usr", promName: "granted_inodes", helpText: quotaGrantedInodesHelp},
+	}
+	for _, pool := range []string{"dt-0x0", "md-0x0"} {
+		for _, metric := range metrics {
+			if err = s.parseQuotaLimits("qmt", filepath.Join(dir, "lustrefs-QMT0000", pool, "glb-usr"), metric, handler); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// dt pools hold kilobytes and md pools hold inodes
+	expected := map[string]float64{
+		"hard_bytes/dt-0x0":     1024000,
+		"granted_bytes/dt-0x0":  819200,
+		"hard_inodes/md-0x0":    100,
+		"granted_inodes/md-0x0": 10,
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected limits: %v, expected %v", results, expected)
+	}
+}
+
+func TestParseQuotaSlave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota_slave")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	slaveDir := filepath.Join(dir, "osd-ldiskfs", "lustrefs-OST0001", "quota_slave")
+	if err = os.MkdirAll(slaveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"acct_user": `usr_accounting:
+- id:      0
+  usage:   { inodes:                  251, kbytes:                 1024 }
+- id:      1000
+  usage:   { inodes:                   12, kbytes:                    4 }
+`,
+		"acct_project": "not supported\n",
+		"info": `target name:    lustrefs-OST0001
+pool ID:        0
+type:           dt
+quota enabled:  ug
+conn to master: setup
+`,
+	}
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(slaveDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type emitted struct {
+		labelValues []string
+		value       float64
+	}
+	var results []emitted
+	handler := func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+		results = append(results, emitted{labelValues, value})
+	}
+	s := &lustreProcfsSource{}
+
+	metric := lustreProcMetric{filename: "quota_slave/acct_user", helpText: quotaUsageBytesHelp}
+	if err = s.parseQuotaUsage("ost", filepath.Join(slaveDir, "acct_user"), metric, handler); err != nil {
+		t.Fatal(err)
+	}
+	expected := []emitted{
+		{[]string{"ost", "lustrefs-OST0001", "usr", "0"}, 1048576},
+		{[]string{"ost", "lustrefs-OST0001", "usr", "1000"}, 4096},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected usage: %+v, expected %+v", results, expected)
+	}
+
+	results = nil
+	metric = lustreProcMetric{filename: "quota_slave/acct_project", helpText: quotaUsageInodesHelp}
+	if err = s.parseQuotaUsage("ost", filepath.Join(slaveDir, "acct_project"), metric, handler); err != nil {
+		t.Fatal(err)
+	}
+	if results != nil {
+		t.Fatalf("Unexpected usage for an unsupported quota type: %+v", results)
+	}
+
+	metric = lustreProcMetric{filename: quotaSlaveInfoFile, helpText: quotaSlaveInfoHelp}
+	if err = s.parseQuotaSlaveInfo("ost", filepath.Join(slaveDir, "info"), metric, handler); err != nil {
+		t.Fatal(err)
+	}
+	expected = []emitted{{[]string{"ost", "lustrefs-OST0001", "ug", "setup"}, 1}}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected info: %+v, expected %+v", results, expected)
+	}
+}
+
+func TestParseQuotaDerived(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota_derived")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	files := map[string]string{
+		"qmt/lustrefs-QMT0000/dt-0x0/glb-usr": `global_pool0_dt_usr
+- id:      0
+  limits:  { hard:                    0, soft:                    0, granted:                    0, time:               604800 }
+- id:      1000
+  limits:  { hard:                 1000, soft:                  500, granted:                  800, time:           1510000600 }
+- id:      1001
+  limits:  { hard:                 1000, soft:                    0, granted:                  100, time:                    0 }
+- id:      1002
+  limits:  { hard:                 1000, soft:                    0, granted:                   10, time:                    0 }
+- id:      1003
+  limits:  { hard:                    0, soft:                    0, granted:                    0, time:                    0 }
+`,
+		"qmt/lustrefs-QMT0000/md-0x0/glb-usr": `global_pool0_md_usr
+- id:      1000
+  limits:  { hard:                  100, soft:                    0, granted:                   64, time:                    0 }
+`,
+		"qmt/lustrefs-QMT0000/md-0x0/glb-grp": `global_pool0_md_grp
+- id:      1000
+  limits:  { hard:                  100, soft:                    0, granted:                   64, time:                    0 }
+`,
+		"osd-ldiskfs/lustrefs-OST0000/quota_slave/acct_user": `usr_accounting:
+- id:      1000
+  usage:   { inodes:                    1, kbytes:                  300 }
+`,
+		"osd-ldiskfs/lustrefs-MDT0000/quota_slave/acct_user": `usr_accounting:
+- id:      1000
+  usage:   { inodes:                   25, kbytes:                    4 }
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		QuotaDerivedMaxIDs = 0
+		quotaNow = time.Now
+	}()
+	QuotaDerivedMaxIDs = 2
+	quotaNow = func() time.Time { return time.Unix(1510000000, 0) }
+
+	results := make(map[string]map[string]float64)
+	handler := func(metricFunc prometheusType, labels []string, labelValues []string, name string, helpText string, value float64) {
+		if results[name] == nil {
+			results[name] = make(map[string]float64)
+		}
+		results[name][labelValues[len(labelValues)-1]] = value
+	}
+	s := &lustreProcfsSource{basePath: dir}
+	metrics := []lustreProcMetric{
+		{filename: "glb-usr", promName: "hard", helpText: quotaHardUtilizationHelp},
+		{filename: "glb-usr", promName: "soft", helpText: quotaSoftUtilizationHelp},
+		{filename: "glb-usr", promName: "grace", helpText: quotaGraceRemainingHelp},
+	}
+	if err = s.parseQuotaDerived("qmt", filepath.Join(dir, "qmt/lustrefs-QMT0000/dt-0x0/glb-usr"), metrics, handler); err != nil {
+		t.Fatal(err)
+	}
+	// The usage of dt pools is the granted space, and ID 1002 has the lowest utilization, so it is dropped by the cap
+	// of 2 IDs
+	expected := map[string]map[string]float64{
+		"hard":                                  {"1000": 0.8, "1001": 0.1},
+		"soft":                                  {"1000": 1.6},
+		"grace":                                 {"1000": 600},
+		"exporter_quota_derived_suppressed_ids": {"usr": 1},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected derived series: %v, expected %v", results, expected)
+	}
+
+	// The usage of md pools is read from the local MDTs, and no ratio is computed for a quota type none of them tracks
+	results = make(map[string]map[string]float64)
+	if err = s.parseQuotaDerived("qmt", filepath.Join(dir, "qmt/lustrefs-QMT0000/md-0x0/glb-usr"), metrics[:1], handler); err != nil {
+		t.Fatal(err)
+	}
+	metrics[0].filename = "glb-grp"
+	if err = s.parseQuotaDerived("qmt", filepath.Join(dir, "qmt/lustrefs-QMT0000/md-0x0/glb-grp"), metrics[:1], handler); err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]map[string]float64{
+		"hard":                                  {"1000": 0.25},
+		"exporter_quota_derived_suppressed_ids": {"usr": 0, "grp": 0},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected derived series: %v, expected %v", results, expected)
+	}
+}
@@ -0,0 +1,119 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	recoveryStatus string = "recovery_status"
+
+	// Help text dedicated to the 'recovery_status' file
+	recoveryStatusHelp            string = "Current recovery status of the target: 1 for the reported status, 0 for the others."
+	recoveryStartHelp             string = "Unix time in seconds at which the last recovery started."
+	recoveryDurationHelp          string = "Duration in seconds of the last completed recovery."
+	recoveryTimeRemainingHelp     string = "Time in seconds left before the ongoing recovery times out."
+	recoveryCompletedClientsHelp  string = "Number of clients that completed recovery."
+	recoveryClientsHelp           string = "Number of clients expected to take part in recovery."
+	recoveryConnectedClientsHelp  string = "Number of clients that reconnected during the ongoing recovery."
+	recoveryEvictedClientsHelp    string = "Number of clients evicted during recovery."
+	recoveryReqReplayClientsHelp  string = "Number of clients replaying requests during the ongoing recovery."
+	recoveryLockReplayClientsHelp string = "Number of clients replaying locks during the ongoing recovery."
+	recoveryReplayedRequestsHelp  string = "Number of requests replayed during recovery."
+	recoveryQueuedRequestsHelp    string = "Number of requests queued for replay during the ongoing recovery."
+	recoveryStatusUnknown         string = "unknown"
+)
+
+// recoveryStatuses are always exported by lustre_recovery_status, so that each of them can be alerted on
+var recoveryStatuses = []string{"complete", "inactive", "recovering", "waiting"}
+
+// readKeyValueFile reads the 'key: value' lines of a file such as recovery_status. Only the first occurrence of each
+// key is kept.
+func readKeyValueFile(path string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string)
+	for _, line := range strings.Split(string(content), "\n") {
+		keyValue := strings.SplitN(line, ":", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(keyValue[0])
+		if _, exists := fields[key]; !exists {
+			fields[key] = strings.TrimSpace(keyValue[1])
+		}
+	}
+	return fields, nil
+}
+
+func getRecoveryStatusMetrics(fields map[string]string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	if helpText == recoveryStatusHelp {
+		status := strings.ToLower(fields["status"])
+		if status == "" {
+			status = recoveryStatusUnknown
+		}
+		known := false
+		for _, s := range recoveryStatuses {
+			value := 0.0
+			if s == status {
+				value = 1
+				known = true
+			}
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "status", extraLabelValue: s})
+		}
+		if !known {
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: 1, extraLabel: "status", extraLabelValue: status})
+		}
+		return metricList, nil
+	}
+
+	// fieldMap matches the given helpText value with the key holding its value and, for the 'x/y' client counts,
+	// the index of the value within the key. 'lock_repay_clients' is spelled the way Lustre reports it.
+	fieldMap := map[string]multistatParsingStruct{
+		recoveryStartHelp:             {pattern: "recovery_start", index: 0},
+		recoveryDurationHelp:          {pattern: "recovery_duration", index: 0},
+		recoveryTimeRemainingHelp:     {pattern: "time_remaining", index: 0},
+		recoveryCompletedClientsHelp:  {pattern: "completed_clients", index: 0},
+		recoveryClientsHelp:           {pattern: "completed_clients", index: 1},
+		recoveryConnectedClientsHelp:  {pattern: "connected_clients", index: 0},
+		recoveryEvictedClientsHelp:    {pattern: "evicted_clients", index: 0},
+		recoveryReqReplayClientsHelp:  {pattern: "req_replay_clients", index: 0},
+		recoveryLockReplayClientsHelp: {pattern: "lock_repay_clients", index: 0},
+		recoveryReplayedRequestsHelp:  {pattern: "replayed_requests", index: 0},
+		recoveryQueuedRequestsHelp:    {pattern: "queued_requests", index: 0},
+	}
+	field, exists := fieldMap[helpText]
+	if !exists {
+		return nil, nil
+	}
+	value, exists := fields[field.pattern]
+	if !exists {
+		return nil, nil
+	}
+	values := strings.Split(value, "/")
+	if field.index >= len(values) {
+		return nil, nil
+	}
+	result, err := strconv.ParseFloat(strings.TrimSpace(values[field.index]), 64)
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: result}}, nil
+}
@@ -0,0 +1,92 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestGetRecoveryStatusMetrics(t *testing.T) {
+	recovering := `status: RECOVERING
+recovery_start: 1510605701
+time_remaining: 120
+connected_clients: 3/4
+req_replay_clients: 1
+lock_repay_clients: 0
+completed_clients: 2/4
+evicted_clients: 1
+replayed_requests: 10
+queued_requests: 2
+next_transno: 8589934593
+`
+	file, err := ioutil.TempFile("", "recovery_status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Remove(file.Name()); err != nil {
+			t.Error(err)
+		}
+	}()
+	if _, err = file.WriteString(recovering); err != nil {
+		t.Fatal(err)
+	}
+	if err = file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fields, err := readKeyValueFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		helpText string
+		expected []float64
+	}{
+		{recoveryStatusHelp, []float64{0, 0, 1, 0}},
+		{recoveryTimeRemainingHelp, []float64{120}},
+		{recoveryCompletedClientsHelp, []float64{2}},
+		{recoveryClientsHelp, []float64{4}},
+		{recoveryConnectedClientsHelp, []float64{3}},
+		{recoveryLockReplayClientsHelp, []float64{0}},
+		{recoveryQueuedRequestsHelp, []float64{2}},
+		{recoveryDurationHelp, nil},
+	}
+	for _, testCase := range testCases {
+		metricList, err := getRecoveryStatusMetrics(fields, "test", testCase.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var values []float64
+		for _, metric := range metricList {
+			values = append(values, metric.value)
+		}
+		if !reflect.DeepEqual(values, testCase.expected) {
+			t.Fatalf("Retrieved unexpected values for %q. Expected: %v, Got: %v", testCase.helpText, testCase.expected, values)
+		}
+	}
+
+	// Statuses Lustre may add later are still reported
+	metricList, err := getRecoveryStatusMetrics(map[string]string{"status": "WAITING_FOR_CLIENTS"}, "test", recoveryStatusHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := metricList[len(metricList)-1]
+	if len(metricList) != len(recoveryStatuses)+1 || last.extraLabelValue != "waiting_for_clients" || last.value != 1 {
+		t.Fatalf("Retrieved unexpected metrics for an unknown status: %v", metricList)
+	}
+}
@@ -0,0 +1,105 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	renameStats string = "rename_stats"
+
+	// Help text dedicated to the 'rename_stats' file
+	renameStatsHelp string = "Total number of renames by kind, bucketed by the size in bytes of the directory, up to the 'size' label."
+)
+
+var (
+	// renameKindPattern matches the '- {kind}:' lines opening the histogram of a kind of rename
+	renameKindPattern = regexp.MustCompile(`^-\s*(\w+):\s*$`)
+	// renameBucketPattern matches the '{size}{unit}: { samples: {n}, pct: {n}, cum_pct: {n} }' lines of a histogram.
+	// Lustre releases spell the sample count 'sample' or 'samples'.
+	renameBucketPattern = regexp.MustCompile(`^([0-9]+)\s*(bytes|KB|MB|GB)?:\s*\{\s*samples?:\s*([0-9]+)`)
+	// renameUnits are the multipliers of the bucket units
+	renameUnits = map[string]uint64{
+		"":      1,
+		"bytes": 1,
+		"KB":    1 << 10,
+		"MB":    1 << 20,
+		"GB":    1 << 30,
+	}
+)
+
+// renameBucket is a bucket of the histogram of a kind of rename: same_dir for renames within a directory, and
+// crossdir_src and crossdir_tgt for the source and target directories of renames across directories.
+type renameBucket struct {
+	kind    string
+	size    string
+	samples float64
+}
+
+// parseRenameStats reads the histograms of a 'rename_stats' file, such as:
+//
+//	rename_stats:
+//	- snapshot_time:  1510781853.10473844
+//	- same_dir:
+//	      4KB: { samples:       3, pct:  50, cum_pct:  50 }
+//	      8KB: { samples:       3, pct:  50, cum_pct: 100 }
+func parseRenameStats(content string) (buckets []renameBucket, err error) {
+	var kind string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if match := renameKindPattern.FindStringSubmatch(line); match != nil {
+			kind = match[1]
+			continue
+		}
+		match := renameBucketPattern.FindStringSubmatch(line)
+		if match == nil || kind == "" {
+			continue
+		}
+		size, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		samples, err := strconv.ParseFloat(match[3], 64)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, renameBucket{kind: kind, size: strconv.FormatUint(size*renameUnits[match[2]], 10), samples: samples})
+	}
+	return buckets, nil
+}
+
+// parseRenameStatsFile emits the buckets of the 'rename_stats' file of an MDT, labeled with their kind and size.
+func (s *lustreProcfsSource) parseRenameStatsFile(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, 0)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	buckets, err := parseRenameStats(string(content))
+	if err != nil {
+		return err
+	}
+	for _, bucket := range buckets {
+		handler(metric.metricFunc, []string{"component", "target", "kind", "size"}, []string{nodeType, nodeName, bucket.kind, bucket.size}, metric.promName, metric.helpText, bucket.samples)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRenameStats(t *testing.T) {
+	content := `rename_stats:
+- snapshot_time:  1510781853.10473844
+- same_dir:
+      512bytes: { samples:       2, pct:  25, cum_pct:  25 }
+      4KB: { samples:       6, pct:  75, cum_pct: 100 }
+- crossdir_src:
+      1MB: { sample:       1, pct: 100, cum_pct: 100 }
+- crossdir_tgt:
+      4KB: { sample:       1, pct: 100, cum_pct: 100 }
+`
+	buckets, err := parseRenameStats(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []renameBucket{
+		{kind: "same_dir", size: "512", samples: 2},
+		{kind: "same_dir", size: "4096", samples: 6},
+		{kind: "crossdir_src", size: "1048576", samples: 1},
+		{kind: "crossdir_tgt", size: "4096", samples: 1},
+	}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Fatalf("Unexpected buckets: %+v, expected %+v", buckets, expected)
+	}
+
+	buckets, err = parseRenameStats("rename_stats:\n- snapshot_time:  1510781853. 10473844\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 0 {
+		t.Fatalf("Expected no buckets, got %+v", buckets)
+	}
+}
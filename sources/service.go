@@ -0,0 +1,233 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Help text dedicated to the PTLRPC service files
+	serviceThreadsStartedHelp    string = "Number of threads currently started by the service."
+	serviceThreadsMinimumHelp    string = "Minimum number of threads the service keeps started."
+	serviceThreadsMaximumHelp    string = "Maximum number of threads the service can start."
+	serviceHistoryLengthHelp     string = "Number of requests currently kept in the request history of the service."
+	serviceHistoryMaximumHelp    string = "Maximum number of requests kept in the request history of the service."
+	serviceRequestsHelp          string = "Total number of requests the service has handled."
+	serviceWaitTimeHelp          string = "Total time in seconds requests waited in the queue of the service before being handled."
+	serviceWaitTimeMaximumHelp   string = "Longest time in seconds a request waited in the queue of the service before being handled."
+	serviceQueueDepthHelp        string = "Sum of the queue depths seen by each request on arrival. Divided by the number of requests, gives the average queue depth."
+	serviceQueueDepthMaximumHelp string = "Deepest request queue seen by a request of the service on arrival."
+	serviceActiveHelp            string = "Sum of the numbers of requests being handled when each request started. Divided by the number of requests, gives the average number of active requests."
+	serviceActiveMaximumHelp     string = "Highest number of requests the service was handling at once."
+	serviceTimeoutHelp           string = "Sum of the timeouts in seconds given to each request handled by the service."
+	serviceBufferSamplesHelp     string = "Total number of times the available request buffers of the service were sampled."
+	serviceBuffersHelp           string = "Sum of the request buffers available each time they were sampled."
+	serviceBuffersMinimumHelp    string = "Lowest number of request buffers that were available to the service."
+	serviceOperationsHelp        string = "Total number of requests the service has handled, by operation."
+)
+
+// servicePaths are the directories holding one subdirectory per PTLRPC service, such as ost_io or mdt_readpage
+var servicePaths = map[string]bool{
+	"ost/OSS/*": true,
+	"mds/MDS/*": true,
+}
+
+// serviceStatsOperations are the operations of a service 'stats' file that describe the service itself, rather
+// than the requests it handles
+var serviceStatsOperations = map[string]bool{
+	"req_waittime": true,
+	"req_qdepth":   true,
+	"req_active":   true,
+	"req_timeout":  true,
+	"reqbuf_avail": true,
+}
+
+func getServiceStatsMetrics(record jobStatsRecord, promName string, helpText string) (metricList []lustreStatsMetric) {
+	if helpText == serviceOperationsHelp {
+		for _, op := range record.operations {
+			if serviceStatsOperations[op.name] {
+				continue
+			}
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: op.samples, extraLabel: "operation", extraLabelValue: op.name})
+		}
+		return metricList
+	}
+
+	// opMap matches the given helpText value with the operation and field holding its value, and the divisor
+	// converting it to the unit of the metric
+	opMap := map[string]struct {
+		operation string
+		field     jobStatsField
+		divisor   float64
+	}{
+		serviceRequestsHelp:          {"req_waittime", jobStatsSamples, 1},
+		serviceWaitTimeHelp:          {"req_waittime", jobStatsSum, 1000000},
+		serviceWaitTimeMaximumHelp:   {"req_waittime", jobStatsMaximum, 1000000},
+		serviceQueueDepthHelp:        {"req_qdepth", jobStatsSum, 1},
+		serviceQueueDepthMaximumHelp: {"req_qdepth", jobStatsMaximum, 1},
+		serviceActiveHelp:            {"req_active", jobStatsSum, 1},
+		serviceActiveMaximumHelp:     {"req_active", jobStatsMaximum, 1},
+		serviceTimeoutHelp:           {"req_timeout", jobStatsSum, 1},
+		serviceBufferSamplesHelp:     {"reqbuf_avail", jobStatsSamples, 1},
+		serviceBuffersHelp:           {"reqbuf_avail", jobStatsSum, 1},
+		serviceBuffersMinimumHelp:    {"reqbuf_avail", jobStatsMinimum, 1},
+	}
+	item, exists := opMap[helpText]
+	if !exists {
+		return nil
+	}
+	op, exists := record.operation(item.operation)
+	if !exists {
+		return nil
+	}
+	return []lustreStatsMetric{{title: promName, help: helpText, value: op.value(item.field) / item.divisor}}
+}
+
+// parseServiceFile emits the values of a template from a file of a PTLRPC service directory, labeled with the
+// service name.
+func (s *lustreProcfsSource) parseServiceFile(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	service, nodeName, err := parseFileElements(filepath.Dir(path), 0)
+	if err != nil {
+		return err
+	}
+	labels := []string{"component", "target", "service"}
+	labelValues := []string{nodeType, nodeName, service}
+	var metricList []lustreStatsMetric
+	switch metric.filename {
+	case timeoutsFile:
+		record, err := readTimeoutsFile(path)
+		if err != nil {
+			return err
+		}
+		// Services report one estimate per service partition, in partition order
+		for partition, at := range record.estimates {
+			for _, item := range getAdaptiveTimeoutMetrics(at, metric.promName, metric.helpText) {
+				if item.extraLabelValue == "" {
+					handler(metric.metricFunc, append(labels, "partition"), append(labelValues, strconv.Itoa(partition)), item.title, item.help, item.value)
+				} else {
+					handler(metric.metricFunc, append(labels, "partition", item.extraLabel), append(labelValues, strconv.Itoa(partition), item.extraLabelValue), item.title, item.help, item.value)
+				}
+			}
+		}
+		return nil
+	case nrsPoliciesFile:
+		content, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		policies, err := parseNRSPolicies(string(content))
+		if err != nil {
+			return err
+		}
+		emitLabeledMetrics(metric, labels, labelValues, getNRSPolicyMetrics(policies, metric.helpText), handler)
+		return nil
+	case nrsTBFRuleFile:
+		content, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		rules, err := parseNRSTBFRules(string(content))
+		if err != nil {
+			return err
+		}
+		emitLabeledMetrics(metric, labels, labelValues, getNRSTBFRuleMetrics(rules, metric.helpText), handler)
+		return nil
+	case stats:
+		statsFile, err := os.Open(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		defer statsFile.Close()
+		record, err := parseStatsRecord(statsFile)
+		if err != nil {
+			return err
+		}
+		metricList = getServiceStatsMetrics(record, metric.promName, metric.helpText)
+	default:
+		if nrsTunableFiles[metric.filename] {
+			content, err := ioutil.ReadFile(filepath.Clean(path))
+			if err != nil {
+				return err
+			}
+			nrsMetrics, err := getNRSTunableMetrics(string(content))
+			if err != nil {
+				return err
+			}
+			emitLabeledMetrics(metric, labels, labelValues, nrsMetrics, handler)
+			return nil
+		}
+		value, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		convertedValue, err := strconv.ParseFloat(strings.TrimSpace(string(value)), 64)
+		if err != nil {
+			return err
+		}
+		metricList = append(metricList, lustreStatsMetric{title: metric.promName, help: metric.helpText, value: convertedValue})
+	}
+	for _, item := range metricList {
+		if item.extraLabelValue == "" {
+			handler(metric.metricFunc, labels, labelValues, item.title, item.help, item.value)
+		} else {
+			handler(metric.metricFunc, append(labels, item.extraLabel), append(labelValues, item.extraLabelValue), item.title, item.help, item.value)
+		}
+	}
+	return nil
+}
+
+// serviceMetricTemplates are the templates shared by the services of the OSS and of the MDS
+func (s *lustreProcfsSource) serviceMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"threads_started", "service_threads_started", serviceThreadsStartedHelp, s.gaugeMetric, false, core},
+		{"threads_min", "service_threads_minimum", serviceThreadsMinimumHelp, s.gaugeMetric, false, core},
+		{"threads_max", "service_threads_maximum", serviceThreadsMaximumHelp, s.gaugeMetric, false, core},
+		{"req_buffer_history_len", "service_request_history_length", serviceHistoryLengthHelp, s.gaugeMetric, false, extended},
+		{"req_buffer_history_max", "service_request_history_maximum", serviceHistoryMaximumHelp, s.gaugeMetric, false, extended},
+		{stats, "service_requests_total", serviceRequestsHelp, s.counterMetric, false, core},
+		{stats, "service_request_wait_seconds_total", serviceWaitTimeHelp, s.counterMetric, false, core},
+		{stats, "service_request_wait_maximum_seconds", serviceWaitTimeMaximumHelp, s.gaugeMetric, false, extended},
+		{stats, "service_request_queue_depth_total", serviceQueueDepthHelp, s.counterMetric, false, core},
+		{stats, "service_request_queue_depth_maximum", serviceQueueDepthMaximumHelp, s.gaugeMetric, false, extended},
+		{stats, "service_active_requests_total", serviceActiveHelp, s.counterMetric, false, core},
+		{stats, "service_active_requests_maximum", serviceActiveMaximumHelp, s.gaugeMetric, false, extended},
+		{stats, "service_request_timeout_seconds_total", serviceTimeoutHelp, s.counterMetric, false, extended},
+		{stats, "service_request_buffer_samples_total", serviceBufferSamplesHelp, s.counterMetric, false, extended},
+		{stats, "service_request_buffers_available_total", serviceBuffersHelp, s.counterMetric, false, extended},
+		{stats, "service_request_buffers_available_minimum", serviceBuffersMinimumHelp, s.gaugeMetric, false, extended},
+		{stats, "service_operations_total", serviceOperationsHelp, s.counterMetric, true, extended},
+		{timeoutsFile, "service_adaptive_timeout_seconds", atCurrentHelp, s.gaugeMetric, true, core},
+		{timeoutsFile, "service_adaptive_timeout_worst_seconds", atWorstHelp, s.gaugeMetric, true, core},
+		{timeoutsFile, "service_adaptive_timeout_worst_time_seconds", atWorstTimeHelp, s.gaugeMetric, true, extended},
+		{timeoutsFile, "service_adaptive_timeout_history_seconds", atHistoryHelp, s.gaugeMetric, true, extended},
+		{nrsPoliciesFile, "nrs_policy_state", nrsPolicyStateHelp, s.gaugeMetric, true, core},
+		{nrsPoliciesFile, "nrs_policy_fallback", nrsPolicyFallbackHelp, s.gaugeMetric, true, extended},
+		{nrsPoliciesFile, "nrs_policy_queued_requests", nrsPolicyQueuedHelp, s.gaugeMetric, true, core},
+		{nrsPoliciesFile, "nrs_policy_active_requests", nrsPolicyActiveHelp, s.gaugeMetric, true, core},
+		{nrsTBFRuleFile, "nrs_tbf_rule_rate", nrsTBFRateHelp, s.gaugeMetric, true, core},
+		{nrsTBFRuleFile, "nrs_tbf_rule_info", nrsTBFInfoHelp, s.gaugeMetric, true, core},
+		{nrsTBFRuleFile, "nrs_tbf_rule_classes", nrsTBFClassesHelp, s.gaugeMetric, true, extended},
+		{"nrs_crrn_quantum", "nrs_crrn_quantum", nrsCRRNQuantumHelp, s.gaugeMetric, true, extended},
+		{"nrs_orr_quantum", "nrs_orr_quantum", nrsORRQuantumHelp, s.gaugeMetric, true, extended},
+		{"nrs_trr_quantum", "nrs_trr_quantum", nrsTRRQuantumHelp, s.gaugeMetric, true, extended},
+		{"nrs_delay_min", "nrs_delay_minimum_seconds", nrsDelayMinHelp, s.gaugeMetric, true, extended},
+		{"nrs_delay_max", "nrs_delay_maximum_seconds", nrsDelayMaxHelp, s.gaugeMetric, true, extended},
+		{"nrs_delay_pct", "nrs_delay_percent", nrsDelayPctHelp, s.gaugeMetric, true, extended},
+	}
+}
@@ -0,0 +1,77 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetServiceStatsMetrics(t *testing.T) {
+	serviceStats := `snapshot_time             1510605701.123456 secs.usecs
+req_waittime              4 samples [usec] 4 6360 8000 64000000
+req_qdepth                4 samples [reqs] 0 3 6 14
+req_active                4 samples [reqs] 1 5 10 40
+req_timeout               4 samples [sec] 1 10 22 202
+reqbuf_avail              9 samples [bufs] 52 64 540 32500
+ost_write                 3 samples [usec] 10 30 60 1400
+obd_ping                  1 samples [usec] 5 5 5 25
+`
+	record, err := parseStatsRecord(strings.NewReader(serviceStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		helpText string
+		expected float64
+	}{
+		{serviceRequestsHelp, 4},
+		{serviceWaitTimeHelp, 0.008},
+		{serviceWaitTimeMaximumHelp, 0.00636},
+		{serviceQueueDepthHelp, 6},
+		{serviceQueueDepthMaximumHelp, 3},
+		{serviceActiveHelp, 10},
+		{serviceActiveMaximumHelp, 5},
+		{serviceTimeoutHelp, 22},
+		{serviceBufferSamplesHelp, 9},
+		{serviceBuffersHelp, 540},
+		{serviceBuffersMinimumHelp, 52},
+	}
+	for _, tc := range testCases {
+		metricList := getServiceStatsMetrics(record, "test", tc.helpText)
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Unexpected metrics for %q: %+v, expected value %f", tc.helpText, metricList, tc.expected)
+		}
+	}
+
+	operations := make(map[string]float64)
+	for _, metric := range getServiceStatsMetrics(record, "test", serviceOperationsHelp) {
+		operations[metric.extraLabelValue] = metric.value
+	}
+	expected := map[string]float64{"ost_write": 3, "obd_ping": 1}
+	if !reflect.DeepEqual(operations, expected) {
+		t.Fatalf("Unexpected operations: %v, expected %v", operations, expected)
+	}
+
+	// Services that haven't handled any request yet have no req_* lines
+	record, err = parseStatsRecord(strings.NewReader("snapshot_time 1510605701.123456 secs.usecs\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList := getServiceStatsMetrics(record, "test", serviceRequestsHelp); metricList != nil {
+		t.Fatalf("Unexpected metrics for an empty stats file: %+v", metricList)
+	}
+}
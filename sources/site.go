@@ -0,0 +1,147 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	siteStats string = "site_stats"
+	hashStats string = "hash_stats"
+
+	// Help text dedicated to the 'site_stats' file
+	siteBusyHelp            string = "Number of objects of the LU object cache currently in use."
+	siteTotalHelp           string = "Number of objects in the LU object cache."
+	sitePopulatedHelp       string = "Number of hash buckets of the LU object cache holding at least one object."
+	siteBucketsHelp         string = "Number of hash buckets of the LU object cache."
+	siteMaxSearchHelp       string = "Maximum number of objects searched through in a hash bucket of the LU object cache."
+	siteCreatedHelp         string = "Total number of objects created in the LU object cache."
+	siteHitsHelp            string = "Total number of lookups found in the LU object cache."
+	siteMissesHelp          string = "Total number of lookups not found in the LU object cache."
+	siteRacesHelp           string = "Total number of objects concurrently added to the LU object cache by two threads."
+	siteDeathRacesHelp      string = "Total number of lookups which found an object of the LU object cache being freed."
+	siteLRUPurgedHelp       string = "Total number of objects purged from the LRU list of the LU object cache."
+	hashBucketsHelp         string = "Current number of buckets of the hash table."
+	hashBucketsMinimumHelp  string = "Minimum number of buckets of the hash table."
+	hashBucketsMaximumHelp  string = "Maximum number of buckets of the hash table."
+	hashThetaHelp           string = "Current load factor of the hash table, in items per bucket."
+	hashRehashesHelp        string = "Total number of times the hash table was resized."
+	hashItemsHelp           string = "Number of items in the hash table."
+	hashMaximumDepthHelp    string = "Depth of the longest chain of the hash table."
+	hashUntrackedDepth      string = "-1"
+	siteStatsMinimumFields  int    = 10
+	hashStatsMinimumColumns int    = 12
+)
+
+// getSiteStatsMetrics reads the single line of a 'site_stats' file, such as '20/56 56/4096 1 104 8597582 103 0 0 0',
+// which holds the busy/total objects, the populated/total hash buckets, the maximum search depth and the created,
+// cache hit, cache miss, cache race, cache death race and LRU purged counts.
+func getSiteStatsMetrics(content string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	fields := strings.Fields(strings.Replace(content, "/", " ", -1))
+	if len(fields) < siteStatsMinimumFields {
+		return nil, fmt.Errorf("unexpected site_stats content: %q", content)
+	}
+	// Releases before the LRU purged count was added lack the last field
+	helps := []string{siteBusyHelp, siteTotalHelp, sitePopulatedHelp, siteBucketsHelp, siteMaxSearchHelp, siteCreatedHelp, siteHitsHelp, siteMissesHelp, siteRacesHelp, siteDeathRacesHelp, siteLRUPurgedHelp}
+	for i, help := range helps {
+		if help != helpText || i >= len(fields) {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value})
+	}
+	return metricList, nil
+}
+
+// getHashStatsMetrics reads the table of a 'hash_stats' file, which has a line per hash table such as:
+//
+//	name     cur   min   max theta t-min t-max flags rehash   count  maxdep maxdepb distribution
+//	NID_HASH 128   128  4096 0.070 0.500 2.000  0x284      0       9      -1      -1 4/0/0/0/0/0/0/0
+//
+// Values are labeled with the name of their hash table. A maximum depth of -1 means the hash table doesn't track it.
+func getHashStatsMetrics(content string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	// columns matches the given helpText value with the column holding its value
+	columns := map[string]int{
+		hashBucketsHelp:        1,
+		hashBucketsMinimumHelp: 2,
+		hashBucketsMaximumHelp: 3,
+		hashThetaHelp:          4,
+		hashRehashesHelp:       8,
+		hashItemsHelp:          9,
+		hashMaximumDepthHelp:   10,
+	}
+	column, exists := columns[helpText]
+	if !exists {
+		return nil, nil
+	}
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < hashStatsMinimumColumns || fields[0] == "name" {
+			continue
+		}
+		if fields[column] == hashUntrackedDepth && helpText == hashMaximumDepthHelp {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[column], 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "hash", extraLabelValue: strings.ToLower(fields[0])})
+	}
+	return metricList, nil
+}
+
+func parseSiteFile(metricType string, path string, promName string, helpText string) ([]lustreStatsMetric, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	if metricType == hashStats {
+		return getHashStatsMetrics(string(content), promName, helpText)
+	}
+	return getSiteStatsMetrics(string(content), promName, helpText)
+}
+
+// siteMetricTemplates are the templates of the LU object cache and of the hash tables of a target, shared by the
+// OSTs and the MDTs
+func (s *lustreProcfsSource) siteMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{siteStats, "object_cache_busy_objects", siteBusyHelp, s.gaugeMetric, false, core},
+		{siteStats, "object_cache_objects", siteTotalHelp, s.gaugeMetric, false, core},
+		{siteStats, "object_cache_populated_buckets", sitePopulatedHelp, s.gaugeMetric, false, extended},
+		{siteStats, "object_cache_buckets", siteBucketsHelp, s.gaugeMetric, false, extended},
+		{siteStats, "object_cache_maximum_search_depth", siteMaxSearchHelp, s.gaugeMetric, false, extended},
+		{siteStats, "object_cache_created_total", siteCreatedHelp, s.counterMetric, false, extended},
+		{siteStats, "object_cache_hits_total", siteHitsHelp, s.counterMetric, false, core},
+		{siteStats, "object_cache_misses_total", siteMissesHelp, s.counterMetric, false, core},
+		{siteStats, "object_cache_races_total", siteRacesHelp, s.counterMetric, false, extended},
+		{siteStats, "object_cache_death_races_total", siteDeathRacesHelp, s.counterMetric, false, extended},
+		{siteStats, "object_cache_lru_purged_total", siteLRUPurgedHelp, s.counterMetric, false, core},
+		{hashStats, "hash_buckets", hashBucketsHelp, s.gaugeMetric, true, extended},
+		{hashStats, "hash_buckets_minimum", hashBucketsMinimumHelp, s.gaugeMetric, true, extended},
+		{hashStats, "hash_buckets_maximum", hashBucketsMaximumHelp, s.gaugeMetric, true, extended},
+		{hashStats, "hash_load_factor", hashThetaHelp, s.gaugeMetric, true, extended},
+		{hashStats, "hash_rehashes_total", hashRehashesHelp, s.counterMetric, true, extended},
+		{hashStats, "hash_items", hashItemsHelp, s.gaugeMetric, true, extended},
+		{hashStats, "hash_maximum_depth", hashMaximumDepthHelp, s.gaugeMetric, true, extended},
+	}
+}
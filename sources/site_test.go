@@ -0,0 +1,88 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetSiteStatsMetrics(t *testing.T) {
+	content := "91/99 88/4096 2 128 167 125 0 0 12\n"
+	expected := map[string]float64{
+		siteBusyHelp:       91,
+		siteTotalHelp:      99,
+		sitePopulatedHelp:  88,
+		siteBucketsHelp:    4096,
+		siteMaxSearchHelp:  2,
+		siteCreatedHelp:    128,
+		siteHitsHelp:       167,
+		siteMissesHelp:     125,
+		siteRacesHelp:      0,
+		siteDeathRacesHelp: 0,
+		siteLRUPurgedHelp:  12,
+	}
+	for help, value := range expected {
+		metricList, err := getSiteStatsMetrics(content, "site", help)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != value {
+			t.Fatalf("Unexpected metrics for %q: %+v, expected %v", help, metricList, value)
+		}
+	}
+
+	// Releases without the LRU purged count
+	metricList, err := getSiteStatsMetrics("91/99 88/4096 2 128 167 125 0 0\n", "site", siteLRUPurgedHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != 0 {
+		t.Fatalf("Expected no LRU purged metric, got %+v", metricList)
+	}
+
+	if _, err := getSiteStatsMetrics("91/99 88/4096\n", "site", siteBusyHelp); err == nil {
+		t.Fatal("Expected an error for truncated content")
+	}
+}
+
+func TestGetHashStatsMetrics(t *testing.T) {
+	content := `name                    cur  min  max theta t-min t-max flags rehash   count  maxdep maxdepb distribution
+UUID_HASH                 128  128 4096 0.070 0.500 2.000  0x284      0       9      -1      -1 4/0/0/0/0/0/0/0
+NID_STATS                  64   64 4096 0.031 0.500 2.000  0x284      1       2       1       3 1/1/0/0/0/0/0/0
+`
+	metricList, err := getHashStatsMetrics(content, "hash_items", hashItemsHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []lustreStatsMetric{
+		{title: "hash_items", help: hashItemsHelp, value: 9, extraLabel: "hash", extraLabelValue: "uuid_hash"},
+		{title: "hash_items", help: hashItemsHelp, value: 2, extraLabel: "hash", extraLabelValue: "nid_stats"},
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected metrics: %+v, expected %+v", metricList, expected)
+	}
+
+	// Hash tables which don't track their maximum depth report -1
+	metricList, err = getHashStatsMetrics(content, "hash_maximum_depth", hashMaximumDepthHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []lustreStatsMetric{
+		{title: "hash_maximum_depth", help: hashMaximumDepthHelp, value: 1, extraLabel: "hash", extraLabelValue: "nid_stats"},
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Unexpected metrics: %+v, expected %+v", metricList, expected)
+	}
+}
@@ -58,12 +58,35 @@ func (s *lustreSysSource) generateHealthStatusTemplates(filter string) {
 	}
 }
 
+func (s *lustreSysSource) generateGenericTemplates(filter string) {
+	metricMap := map[string][]lustreHelpStruct{
+		"": {
+			{"at_min", "adaptive_timeout_minimum_seconds", atMinimumHelp, s.gaugeMetric, false, core},
+			{"at_max", "adaptive_timeout_maximum_seconds", atMaximumHelp, s.gaugeMetric, false, core},
+			{"at_extra", "adaptive_timeout_extra_seconds", atExtraHelp, s.gaugeMetric, false, extended},
+			{"at_early_margin", "adaptive_timeout_early_margin_seconds", atEarlyMarginHelp, s.gaugeMetric, false, extended},
+			{"at_history", "adaptive_timeout_history_window_seconds", atHistoryTimeHelp, s.gaugeMetric, false, extended},
+		},
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, "generic", path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, newMetric)
+			}
+		}
+	}
+}
+
 func newLustreSysSource() LustreSource {
 	var l lustreSysSource
 	l.basePath = filepath.Join(SysLocation, "fs/lustre")
 	if HealthStatusEnabled != disabled {
 		l.generateHealthStatusTemplates(HealthStatusEnabled)
 	}
+	if GenericEnabled != disabled {
+		l.generateGenericTemplates(GenericEnabled)
+	}
 	return &l
 }
 
@@ -80,14 +103,11 @@ func (s *lustreSysSource) Update(ch chan<- prometheus.Metric) (err error) {
 			continue
 		}
 		for _, path := range paths {
-			switch metric.filename {
-			case "health_check":
-				err = s.parseTextFile(metric.source, "health_check", path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
-					ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
-				})
-				if err != nil {
-					return err
-				}
+			err = s.parseTextFile(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
+				ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
+			})
+			if err != nil {
+				return err
 			}
 		}
 	}
@@ -119,6 +139,12 @@ func (s *lustreSysSource) parseTextFile(nodeType string, metricType string, path
 			}
 			handler(nodeType, nodeName, promName, helpText, value)
 		}
+	default:
+		value, err := strconv.ParseFloat(strings.TrimSpace(fileString), 64)
+		if err != nil {
+			return err
+		}
+		handler(nodeType, nodeName, promName, helpText, value)
 	}
 	return nil
 }
@@ -0,0 +1,153 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	timeoutsFile string = "timeouts"
+
+	// Help text dedicated to the 'timeouts' files
+	atCurrentHelp   string = "Current adaptive timeout estimate in seconds."
+	atWorstHelp     string = "Worst adaptive timeout estimate in seconds seen over the adaptive timeout history."
+	atWorstTimeHelp string = "Unix time in seconds at which the worst adaptive timeout estimate was seen."
+	atHistoryHelp   string = "Adaptive timeout estimate in seconds of each slot of the history ring, the current slot being 0."
+	atLastReplyHelp string = "Unix time in seconds at which the last reply was received on the import."
+
+	// Help text dedicated to the global adaptive timeout parameters
+	atMinimumHelp     string = "Minimum adaptive timeout in seconds."
+	atMaximumHelp     string = "Maximum adaptive timeout in seconds."
+	atExtraHelp       string = "Time in seconds by which servers extend the deadline of a request they can't serve in time."
+	atEarlyMarginHelp string = "Time in seconds before the deadline of a request at which servers send an early reply."
+	atHistoryTimeHelp string = "Time in seconds over which the adaptive timeout history is kept."
+)
+
+// adaptiveTimeoutPattern matches the '{name} : cur {n} worst {n} (at {timestamp}, {n}s ago) {history...}' lines of a
+// timeouts file
+var adaptiveTimeoutPattern = regexp.MustCompile(`^\s*([^:]+?)\s*:\s*cur\s+([0-9]+)\s+worst\s+([0-9]+)\s+\(at\s+([0-9]+),[^)]*\)((?:\s+[0-9]+)*)\s*$`)
+
+// adaptiveTimeout is an adaptive timeout estimate, such as the service time of a service or of an import portal, or
+// the network latency of an import.
+type adaptiveTimeout struct {
+	name      string
+	current   float64
+	worst     float64
+	worstTime float64
+	history   []float64
+}
+
+// timeoutsRecord is the content of a timeouts file. Service files hold one 'service' estimate per service partition,
+// while import files hold the time of the last reply, the 'network' estimate and one estimate per portal.
+type timeoutsRecord struct {
+	lastReply  float64
+	hasReplied bool
+	estimates  []adaptiveTimeout
+}
+
+func parseTimeoutsFile(content string) (record timeoutsRecord, err error) {
+	for _, line := range strings.Split(content, "\n") {
+		if match := adaptiveTimeoutPattern.FindStringSubmatch(line); match != nil {
+			at := adaptiveTimeout{name: match[1]}
+			values := []*float64{&at.current, &at.worst, &at.worstTime}
+			for i, value := range values {
+				if *value, err = strconv.ParseFloat(match[i+2], 64); err != nil {
+					return record, err
+				}
+			}
+			for _, field := range strings.Fields(match[5]) {
+				value, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return record, err
+				}
+				at.history = append(at.history, value)
+			}
+			record.estimates = append(record.estimates, at)
+			continue
+		}
+		keyValue := strings.SplitN(line, ":", 2)
+		if len(keyValue) == 2 && strings.TrimSpace(keyValue[0]) == "last reply" {
+			// 'last reply : {timestamp}, {n}s ago'
+			timestamp := strings.TrimSpace(strings.SplitN(keyValue[1], ",", 2)[0])
+			if record.lastReply, err = strconv.ParseFloat(timestamp, 64); err != nil {
+				return record, err
+			}
+			record.hasReplied = true
+		}
+	}
+	return record, nil
+}
+
+func readTimeoutsFile(path string) (timeoutsRecord, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return timeoutsRecord{}, err
+	}
+	return parseTimeoutsFile(string(content))
+}
+
+// getAdaptiveTimeoutMetrics returns the values of a template for an adaptive timeout estimate. The values of the
+// history ring are labeled with their slot.
+func getAdaptiveTimeoutMetrics(at adaptiveTimeout, promName string, helpText string) (metricList []lustreStatsMetric) {
+	switch helpText {
+	case atCurrentHelp:
+		return []lustreStatsMetric{{title: promName, help: helpText, value: at.current}}
+	case atWorstHelp:
+		return []lustreStatsMetric{{title: promName, help: helpText, value: at.worst}}
+	case atWorstTimeHelp:
+		return []lustreStatsMetric{{title: promName, help: helpText, value: at.worstTime}}
+	case atHistoryHelp:
+		for slot, value := range at.history {
+			metricList = append(metricList, lustreStatsMetric{title: promName, help: helpText, value: value, extraLabel: "slot", extraLabelValue: strconv.Itoa(slot)})
+		}
+	}
+	return metricList
+}
+
+// parseImportTimeouts emits the values of a template from the timeouts file of an import. Estimates are labeled
+// 'network' for the network latency and 'portal_{n}' for the service time of each portal.
+func (s *lustreProcfsSource) parseImportTimeouts(nodeType string, path string, metric lustreProcMetric, handler func(prometheusType, []string, []string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, 0)
+	if err != nil {
+		return err
+	}
+	record, err := readTimeoutsFile(path)
+	if err != nil {
+		return err
+	}
+	labels := []string{"component", "target"}
+	labelValues := []string{nodeType, nodeName}
+	if metric.helpText == atLastReplyHelp {
+		if record.hasReplied {
+			handler(metric.metricFunc, labels, labelValues, metric.promName, metric.helpText, record.lastReply)
+		}
+		return nil
+	}
+	for _, at := range record.estimates {
+		estimate := strings.Join(strings.Fields(at.name), "_")
+		for _, item := range getAdaptiveTimeoutMetrics(at, metric.promName, metric.helpText) {
+			if item.extraLabelValue == "" {
+				handler(metric.metricFunc, append(labels, "estimate"), append(labelValues, estimate), item.title, item.help, item.value)
+			} else {
+				handler(metric.metricFunc, append(labels, "estimate", item.extraLabel), append(labelValues, estimate, item.extraLabelValue), item.title, item.help, item.value)
+			}
+		}
+	}
+	return nil
+}
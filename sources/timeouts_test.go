@@ -0,0 +1,61 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTimeoutsFile(t *testing.T) {
+	importTimeouts := `last reply : 1510950459, 0s ago
+network    : cur   1  worst   1 (at 1510766261, 184198s ago)   1   1   1   1 
+portal 6   : cur   2  worst  31 (at 1510777840, 172619s ago)   2   0   0   0 
+`
+	record, err := parseTimeoutsFile(importTimeouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := timeoutsRecord{
+		lastReply:  1510950459,
+		hasReplied: true,
+		estimates: []adaptiveTimeout{
+			{name: "network", current: 1, worst: 1, worstTime: 1510766261, history: []float64{1, 1, 1, 1}},
+			{name: "portal 6", current: 2, worst: 31, worstTime: 1510777840, history: []float64{2, 0, 0, 0}},
+		},
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Fatalf("Unexpected record: %+v, expected %+v", record, expected)
+	}
+
+	serviceTimeouts := `   service : cur  10  worst  10 (at 1510605393, 177213s ago)   0   0   0   0 
+   service : cur   1  worst  31 (at 1510777843, 4763s ago)   1   1   1   1 
+`
+	record, err = parseTimeoutsFile(serviceTimeouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.hasReplied || len(record.estimates) != 2 || record.estimates[1].worst != 31 {
+		t.Fatalf("Unexpected record: %+v", record)
+	}
+
+	history := getAdaptiveTimeoutMetrics(record.estimates[1], "test", atHistoryHelp)
+	if len(history) != 4 || history[3].extraLabel != "slot" || history[3].extraLabelValue != "3" || history[3].value != 1 {
+		t.Fatalf("Unexpected history metrics: %+v", history)
+	}
+	worstTime := getAdaptiveTimeoutMetrics(record.estimates[1], "test", atWorstTimeHelp)
+	if len(worstTime) != 1 || worstTime[0].value != 1510777843 {
+		t.Fatalf("Unexpected worst time metrics: %+v", worstTime)
+	}
+}